@@ -4,9 +4,14 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"strings"
+	"syscall"
 
+	"github.com/renja-g/axiom/internal/config"
 	"github.com/renja-g/axiom/internal/generator"
+	"github.com/renja-g/axiom/internal/loader"
 	"github.com/renja-g/axiom/internal/runner"
 	"github.com/renja-g/axiom/internal/sandbox"
 	"github.com/renja-g/axiom/mutator"
@@ -17,8 +22,32 @@ func main() {
 	pkg := flag.String("pkg", "./...", "Go package pattern to test (relative to path)")
 	listOnly := flag.Bool("list", false, "List mutations without running tests")
 	verbose := flag.Bool("v", false, "Verbose: print test output per mutation")
+	sandboxMode := flag.String("sandbox", "copy", "Sandbox strategy: copy (reflink or copy files), link (hard-link files), worktree (git worktree at HEAD) or overlay (go test -overlay, no copy)")
+	configPath := flag.String("config", "", "Path to a JSON config file (testArgs, env, formatStrings, mutators, race)")
+	testArgs := flag.String("test-args", "", "Extra arguments passed to every go test invocation, e.g. \"-race -count=1 -tags=integration\"")
+	formatStrings := flag.Bool("format-strings", false, "Also mutate format strings of printf-style calls")
+	mutators := flag.String("mutators", "", "Comma-separated mutator name prefixes to enable, e.g. \"Nil_,Arithmetic_\" (default all)")
+	timeout := flag.Duration("timeout", runner.DefaultTimeout, "Timeout of the go test run of each mutation; mutations exceeding it are killed (0 for go test's default)")
+	race := flag.Bool("race", false, "Test mutations of concurrency mutators with the race detector (requires cgo)")
+	var testEnv stringList
+	flag.Var(&testEnv, "test-env", "KEY=VALUE environment variable for go test invocations (repeatable)")
 	flag.Parse()
 
+	cfg, err := loadConfig(*configPath, *testArgs, testEnv)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(2)
+	}
+	cfg.FormatStrings = cfg.FormatStrings || *formatStrings
+	cfg.Mutators = append(cfg.Mutators, splitList(*mutators)...)
+	cfg.Race = cfg.Race || *race
+
+	reg, err := mutator.NewRegistryWithOptions(mutator.Options{FormatStrings: cfg.FormatStrings, Mutators: cfg.Mutators})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(2)
+	}
+
 	abspath, err := filepath.Abs(*root)
 	if err != nil {
 		panic(err)
@@ -26,14 +55,19 @@ func main() {
 
 	pkgArg := normalizePkgArg(*pkg, abspath)
 
-	sb, err := sandbox.New(abspath)
+	sb, err := newSandbox(*sandboxMode, abspath)
 	if err != nil {
-		panic(err)
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(2)
 	}
 	defer sb.Cleanup()
+	cleanupOnSignal(sb)
 
-	reg := mutator.NewRegistry()
+	ld := loader.New()
 	gen := generator.New(reg)
+	gen.WithLoader(ld)
+	gen.WithBuildTags(config.BuildTags(cfg.TestArgs))
+	gen.WithEnv(cfg.Env)
 	gen.WithPathMapper(func(path string) string {
 		return sb.OriginalPath(path)
 	})
@@ -41,10 +75,17 @@ func main() {
 	if err != nil {
 		panic(err)
 	}
+	for _, warning := range gen.Warnings() {
+		fmt.Fprintln(os.Stderr, "Warning:", warning)
+	}
 
 	fmt.Printf("Discovered %d mutations\n", len(muts))
 	for i, m := range muts {
-		fmt.Printf("[%d] %s %s:%d:%d\n", i+1, m.Mutator.Name(), displayPath(abspath, m.FilePath), m.Line, m.Column)
+		suffix := ""
+		if m.NotCompiled {
+			suffix = " (not compiled)"
+		}
+		fmt.Printf("[%d] %s %s:%d:%d%s\n", i+1, m.Mutator.Name(), displayPath(abspath, m.FilePath), m.Line, m.Column, suffix)
 	}
 
 	if *listOnly {
@@ -52,8 +93,29 @@ func main() {
 	}
 
 	r := runner.New(sb)
-	killed, survived := 0, 0
+	r.WithLoader(ld)
+	r.WithTestArgs(cfg.TestArgs)
+	r.WithEnv(cfg.Env)
+	r.WithRace(cfg.Race)
+	r.WithTimeout(*timeout)
+	var total tally
+	perModule := make(map[string]*tally)
+	var moduleOrder []string
 	for i, m := range muts {
+		mod, ok := perModule[m.Module]
+		if !ok {
+			mod = &tally{}
+			perModule[m.Module] = mod
+			moduleOrder = append(moduleOrder, m.Module)
+		}
+		total.mutations++
+		mod.mutations++
+
+		if m.NotCompiled {
+			total.notCompiled++
+			mod.notCompiled++
+			continue
+		}
 		fmt.Printf("\n[%d/%d] Testing %s at %s:%d:%d\n", i+1, len(muts), m.Mutator.Name(), displayPath(abspath, m.FilePath), m.Line, m.Column)
 		res, err := r.TestMutation(m, pkgArg)
 		if err != nil {
@@ -65,14 +127,116 @@ func main() {
 		}
 		if res.Killed {
 			fmt.Println("  ✓ KILLED")
-			killed++
+			total.killed++
+			mod.killed++
 		} else {
 			fmt.Println("  ✗ SURVIVED")
-			survived++
+			total.survived++
+			mod.survived++
+		}
+	}
+
+	if len(moduleOrder) > 1 {
+		fmt.Println()
+		for _, name := range moduleOrder {
+			label := name
+			if label == "" {
+				label = "(no module)"
+			}
+			fmt.Printf("Module %s: %s\n", label, perModule[name])
 		}
 	}
 
-	fmt.Printf("\nKilled: %d  Survived: %d  Score: %.2f%%\n", killed, survived, percent(killed, len(muts)))
+	fmt.Printf("\n%s\n", &total)
+}
+
+// tally accumulates mutation outcomes for a summary line.
+type tally struct {
+	mutations, killed, survived, notCompiled int
+}
+
+func (t *tally) String() string {
+	return fmt.Sprintf("Killed: %d  Survived: %d  Not compiled: %d  Score: %.2f%%", t.killed, t.survived, t.notCompiled, percent(t.killed, t.mutations-t.notCompiled))
+}
+
+// newSandbox creates a sandbox of the given strategy for root.
+func newSandbox(mode, root string) (sandbox.Sandbox, error) {
+	switch mode {
+	case "copy", "":
+		return sandbox.New(root)
+	case "link":
+		return sandbox.NewLinked(root)
+	case "worktree":
+		return sandbox.NewWorktree(root)
+	case "overlay":
+		return sandbox.NewOverlay(root)
+	default:
+		return nil, fmt.Errorf("-sandbox: unknown strategy %q (want copy, link, worktree or overlay)", mode)
+	}
+}
+
+// cleanupOnSignal removes the sandbox when the process is interrupted or terminated,
+// since deferred calls don't run when a signal ends the process.
+func cleanupOnSignal(sb sandbox.Sandbox) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		sb.Cleanup()
+		fmt.Fprintf(os.Stderr, "\naxiom: %v, sandbox removed\n", sig)
+		os.Exit(1)
+	}()
+}
+
+// stringList is a flag.Value collecting every occurrence of a repeatable flag.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// loadConfig merges the optional config file with the -test-args and -test-env flags.
+// Command line values are appended after the ones from the file.
+func loadConfig(path, testArgs string, testEnv []string) (config.Config, error) {
+	var cfg config.Config
+	if path != "" {
+		loaded, err := config.Load(path)
+		if err != nil {
+			return cfg, err
+		}
+		cfg = loaded
+	}
+
+	args, err := config.SplitArgs(testArgs)
+	if err != nil {
+		return cfg, fmt.Errorf("-test-args: %w", err)
+	}
+	cfg.TestArgs = append(cfg.TestArgs, args...)
+
+	for _, kv := range testEnv {
+		if !strings.Contains(kv, "=") {
+			return cfg, fmt.Errorf("-test-env: %q is not of the form KEY=VALUE", kv)
+		}
+	}
+	cfg.Env = append(cfg.Env, testEnv...)
+
+	return cfg, nil
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries.
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
 }
 
 func normalizePkgArg(pkg, root string) string {
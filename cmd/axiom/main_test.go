@@ -4,6 +4,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -168,3 +169,54 @@ func TestPercent(t *testing.T) {
 		})
 	}
 }
+
+func TestLoadConfigMergesFileAndFlags(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "axiom.json")
+	if err := os.WriteFile(path, []byte(`{"testArgs": ["-race"], "env": ["A=1"]}`), 0o644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	cfg, err := loadConfig(path, `-count=1 -ldflags="-X main.v=1"`, []string{"B=2"})
+	if err != nil {
+		t.Fatalf("loadConfig returned error: %v", err)
+	}
+
+	if want := []string{"-race", "-count=1", "-ldflags=-X main.v=1"}; !reflect.DeepEqual(cfg.TestArgs, want) {
+		t.Fatalf("TestArgs = %q, want %q", cfg.TestArgs, want)
+	}
+	if want := []string{"A=1", "B=2"}; !reflect.DeepEqual(cfg.Env, want) {
+		t.Fatalf("Env = %q, want %q", cfg.Env, want)
+	}
+}
+
+func TestLoadConfigRejectsInvalidEnv(t *testing.T) {
+	if _, err := loadConfig("", "", []string{"NOVALUE"}); err == nil {
+		t.Fatal("expected error for -test-env without '='")
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{in: "", want: nil},
+		{in: "Nil_", want: []string{"Nil_"}},
+		{in: " Nil_, Arithmetic_ADD ,,", want: []string{"Nil_", "Arithmetic_ADD"}},
+	}
+
+	for _, tc := range tests {
+		if got := splitList(tc.in); !reflect.DeepEqual(got, tc.want) {
+			t.Fatalf("splitList(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestTallyString(t *testing.T) {
+	tl := &tally{mutations: 5, killed: 2, survived: 2, notCompiled: 1}
+
+	want := "Killed: 2  Survived: 2  Not compiled: 1  Score: 50.00%"
+	if got := tl.String(); got != want {
+		t.Fatalf("tally.String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,242 @@
+package astutil
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"reflect"
+	"strconv"
+)
+
+// Pos returns the position a mutation of n is reported at: the operator for binary
+// expressions, assignments and inc/dec statements, and the start of the node otherwise.
+func Pos(n ast.Node) token.Pos {
+	switch n := n.(type) {
+	case *ast.BinaryExpr:
+		return n.OpPos
+	case *ast.AssignStmt:
+		return n.TokPos
+	case *ast.IncDecStmt:
+		return n.TokPos
+	}
+	return n.Pos()
+}
+
+// Kind returns the dynamic type name of n, e.g. "*ast.ExprStmt".
+// Together with Pos and End it identifies a node across separate parses of the same source.
+func Kind(n ast.Node) string {
+	return fmt.Sprintf("%T", n)
+}
+
+// Replace substitutes replacement for target in the tree rooted at root.
+// A nil replacement removes target from the list holding it (e.g. a case clause from a
+// switch body) or clears the optional field referencing it (e.g. an if statement's else).
+// It reports whether target was found and could be replaced.
+func Replace(root, target, replacement ast.Node) bool {
+	parent := Parent(root, target)
+	if parent == nil {
+		return false
+	}
+	return replaceChild(parent, target, replacement)
+}
+
+// Parent returns the node directly enclosing target in the tree rooted at root, or nil.
+func Parent(root, target ast.Node) ast.Node {
+	var parent ast.Node
+	var stack []ast.Node
+	found := false
+
+	ast.Inspect(root, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return false
+		}
+		if n == target {
+			found = true
+			if len(stack) > 0 {
+				parent = stack[len(stack)-1]
+			}
+			return false
+		}
+		stack = append(stack, n)
+		return true
+	})
+
+	return parent
+}
+
+// replaceChild replaces target in one of parent's fields using reflection,
+// so every node type is supported without enumerating them.
+func replaceChild(parent, target, replacement ast.Node) bool {
+	v := reflect.ValueOf(parent)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	v = v.Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
+		field := v.Field(i)
+		switch field.Kind() {
+		case reflect.Interface, reflect.Ptr:
+			if field.IsNil() || field.Interface() != any(target) {
+				continue
+			}
+			return setValue(field, replacement)
+		case reflect.Slice:
+			for j := 0; j < field.Len(); j++ {
+				elem := field.Index(j)
+				if (elem.Kind() != reflect.Interface && elem.Kind() != reflect.Ptr) || elem.IsNil() || elem.Interface() != any(target) {
+					continue
+				}
+				if replacement == nil {
+					field.Set(reflect.AppendSlice(field.Slice(0, j), field.Slice(j+1, field.Len())))
+					return true
+				}
+				return setValue(elem, replacement)
+			}
+		}
+	}
+	return false
+}
+
+func setValue(field reflect.Value, replacement ast.Node) bool {
+	if replacement == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return true
+	}
+	rv := reflect.ValueOf(replacement)
+	if !rv.Type().AssignableTo(field.Type()) {
+		return false
+	}
+	field.Set(rv)
+	return true
+}
+
+// HasImport reports whether file imports the package with the given path.
+func HasImport(file *ast.File, path string) bool {
+	for _, spec := range file.Imports {
+		if importPath(spec) == path {
+			return true
+		}
+	}
+	return false
+}
+
+// AddImport adds an import of path to file, extending its first import declaration
+// or starting a new one right after the package clause.
+func AddImport(file *ast.File, path string) {
+	spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(path)}}
+	file.Imports = append(file.Imports, spec)
+
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			gen.Specs = append(gen.Specs, spec)
+			return
+		}
+	}
+	// position the new declaration before any comments following the package clause
+	spec.Path.ValuePos = file.Name.End()
+	decl := &ast.GenDecl{TokPos: file.Name.End(), Tok: token.IMPORT, Specs: []ast.Spec{spec}}
+	file.Decls = append([]ast.Decl{decl}, file.Decls...)
+}
+
+// UsesPackage reports whether n refers to a package member through name, as in name.Member.
+func UsesPackage(n ast.Node, name string) bool {
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == name {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+func importPath(spec *ast.ImportSpec) string {
+	path, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return ""
+	}
+	return path
+}
+
+// Terminates reports whether stmt is a terminating statement as defined by the Go spec,
+// e.g. a return, a panic call, or an if-else whose branches both terminate.
+// Labelled breaks are not tracked, so loops and switches containing any break don't terminate.
+func Terminates(stmt ast.Stmt) bool {
+	switch s := stmt.(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.BranchStmt:
+		return s.Tok == token.GOTO
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		ident, ok := call.Fun.(*ast.Ident)
+		return ok && ident.Name == "panic"
+	case *ast.BlockStmt:
+		return len(s.List) > 0 && Terminates(s.List[len(s.List)-1])
+	case *ast.IfStmt:
+		return s.Else != nil && Terminates(s.Body) && Terminates(s.Else)
+	case *ast.LabeledStmt:
+		return Terminates(s.Stmt)
+	case *ast.ForStmt:
+		return s.Cond == nil && !hasBreak(s.Body)
+	case *ast.SwitchStmt:
+		return clausesTerminate(s.Body, true)
+	case *ast.TypeSwitchStmt:
+		return clausesTerminate(s.Body, true)
+	case *ast.SelectStmt:
+		return clausesTerminate(s.Body, false)
+	}
+	return false
+}
+
+// clausesTerminate reports whether every clause in body terminates or falls through without breaking,
+// and, when needsDefault is set, there is a default clause.
+func clausesTerminate(body *ast.BlockStmt, needsDefault bool) bool {
+	hasDefault := false
+	for _, clause := range body.List {
+		var stmts []ast.Stmt
+		switch c := clause.(type) {
+		case *ast.CaseClause:
+			hasDefault = hasDefault || c.List == nil
+			stmts = c.Body
+		case *ast.CommClause:
+			stmts = c.Body
+		}
+		if len(stmts) == 0 || hasBreak(&ast.BlockStmt{List: stmts}) {
+			return false
+		}
+		last := stmts[len(stmts)-1]
+		if branch, ok := last.(*ast.BranchStmt); ok && branch.Tok == token.FALLTHROUGH {
+			continue
+		}
+		if !Terminates(last) {
+			return false
+		}
+	}
+	return hasDefault || !needsDefault
+}
+
+// hasBreak reports whether n contains a break statement.
+func hasBreak(n ast.Node) bool {
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		if branch, ok := n.(*ast.BranchStmt); ok && branch.Tok == token.BREAK {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
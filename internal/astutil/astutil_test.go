@@ -0,0 +1,236 @@
+package astutil
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"testing"
+)
+
+const source = `package sample
+
+func f(x int) int {
+	x++
+	if x > 1 {
+		return 1
+	} else {
+		return 2
+	}
+}
+`
+
+func parse(t *testing.T) (*token.FileSet, *ast.File, *ast.FuncDecl) {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "sample.go", source, 0)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	return fset, file, file.Decls[0].(*ast.FuncDecl)
+}
+
+func TestPos(t *testing.T) {
+	fset, _, fn := parse(t)
+
+	inc := fn.Body.List[0].(*ast.IncDecStmt)
+	if got := fset.Position(Pos(inc)); got.Line != 4 || got.Column != 3 {
+		t.Fatalf("Pos(x++) = %d:%d, want 4:3", got.Line, got.Column)
+	}
+
+	cond := fn.Body.List[1].(*ast.IfStmt).Cond
+	if got := fset.Position(Pos(cond)); got.Line != 5 || got.Column != 7 {
+		t.Fatalf("Pos(x > 1) = %d:%d, want 5:7", got.Line, got.Column)
+	}
+
+	if got := fset.Position(Pos(fn.Body)); got.Line != 3 || got.Column != 19 {
+		t.Fatalf("Pos(body) = %d:%d, want 3:19", got.Line, got.Column)
+	}
+}
+
+func TestKind(t *testing.T) {
+	if got := Kind(&ast.ExprStmt{}); got != "*ast.ExprStmt" {
+		t.Fatalf("Kind() = %q, want %q", got, "*ast.ExprStmt")
+	}
+}
+
+func TestReplace(t *testing.T) {
+	tests := []struct {
+		name   string
+		target func(fn *ast.FuncDecl) ast.Node
+		with   ast.Node
+		want   bool
+		output string // expected in the printed source
+		absent string // must no longer appear in the printed source
+	}{
+		{
+			name:   "expression in field",
+			target: func(fn *ast.FuncDecl) ast.Node { return fn.Body.List[1].(*ast.IfStmt).Cond },
+			with:   ast.NewIdent("true"),
+			want:   true,
+			output: "if true {",
+		},
+		{
+			name:   "statement in list",
+			target: func(fn *ast.FuncDecl) ast.Node { return fn.Body.List[0] },
+			with:   &ast.EmptyStmt{Implicit: true},
+			want:   true,
+			output: "if x > 1 {",
+			absent: "x++",
+		},
+		{
+			name:   "nil removes optional field",
+			target: func(fn *ast.FuncDecl) ast.Node { return fn.Body.List[1].(*ast.IfStmt).Else },
+			with:   nil,
+			want:   true,
+			output: "return 1",
+			absent: "else",
+		},
+		{
+			name:   "nil removes list element",
+			target: func(fn *ast.FuncDecl) ast.Node { return fn.Body.List[0] },
+			with:   nil,
+			want:   true,
+			output: "if x > 1 {",
+			absent: "x++",
+		},
+		{
+			name:   "incompatible replacement is rejected",
+			target: func(fn *ast.FuncDecl) ast.Node { return fn.Body.List[1].(*ast.IfStmt).Body },
+			with:   ast.NewIdent("x"),
+			want:   false,
+		},
+		{
+			name:   "unknown target",
+			target: func(fn *ast.FuncDecl) ast.Node { return ast.NewIdent("missing") },
+			with:   ast.NewIdent("x"),
+			want:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fset, file, fn := parse(t)
+
+			if got := Replace(file, tc.target(fn), tc.with); got != tc.want {
+				t.Fatalf("Replace() = %v, want %v", got, tc.want)
+			}
+			if !tc.want {
+				return
+			}
+
+			var buf bytes.Buffer
+			if err := printer.Fprint(&buf, fset, file); err != nil {
+				t.Fatalf("failed to print: %v", err)
+			}
+			if !bytes.Contains(buf.Bytes(), []byte(tc.output)) {
+				t.Fatalf("printed source does not contain %q:\n%s", tc.output, buf.String())
+			}
+			if tc.absent != "" && bytes.Contains(buf.Bytes(), []byte(tc.absent)) {
+				t.Fatalf("printed source still contains %q:\n%s", tc.absent, buf.String())
+			}
+		})
+	}
+}
+
+func TestParent(t *testing.T) {
+	_, file, fn := parse(t)
+
+	if got := Parent(file, fn.Body); got != fn {
+		t.Fatalf("Parent(body) = %T, want the function declaration", got)
+	}
+	if got := Parent(file, file); got != nil {
+		t.Fatalf("Parent(root) = %T, want nil", got)
+	}
+}
+
+func TestAddImport(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "extends existing import declaration",
+			src:  "//go:build linux\n\npackage sample\n\nimport \"fmt\"\n\nvar _ = fmt.Sprint\n",
+			want: "//go:build linux\n\npackage sample\n\nimport (\n\t\"fmt\"\n\t\"errors\"\n)\n\nvar _ = fmt.Sprint\n",
+		},
+		{
+			name: "starts import declaration after package clause",
+			src:  "// Package sample is a sample.\npackage sample\n\n// x is a variable.\nvar x int\n",
+			want: "// Package sample is a sample.\npackage sample\n\nimport \"errors\"\n\n// x is a variable.\nvar x int\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, "sample.go", tc.src, parser.ParseComments)
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			if HasImport(file, "errors") {
+				t.Fatalf("HasImport() = true before AddImport")
+			}
+
+			AddImport(file, "errors")
+
+			if !HasImport(file, "errors") {
+				t.Fatalf("HasImport() = false after AddImport")
+			}
+			var buf bytes.Buffer
+			if err := printer.Fprint(&buf, fset, file); err != nil {
+				t.Fatalf("failed to print: %v", err)
+			}
+			if got := buf.String(); got != tc.want {
+				t.Fatalf("printed\n%s\nwant\n%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUsesPackage(t *testing.T) {
+	expr, err := parser.ParseExpr(`f(errors.New("x"))`)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	if !UsesPackage(expr, "errors") {
+		t.Fatalf("UsesPackage(errors) = false, want true")
+	}
+	if UsesPackage(expr, "fmt") {
+		t.Fatalf("UsesPackage(fmt) = true, want false")
+	}
+}
+
+func TestTerminates(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want bool
+	}{
+		{name: "return", src: "return", want: true},
+		{name: "panic", src: `panic("x")`, want: true},
+		{name: "call", src: "f()", want: false},
+		{name: "if-else returning", src: "if x { return } else { panic(1) }", want: true},
+		{name: "if without else", src: "if x { return }", want: false},
+		{name: "infinite loop", src: "for { f() }", want: true},
+		{name: "loop with break", src: "for { break }", want: false},
+		{name: "switch with default", src: "switch { case x: return; default: return }", want: true},
+		{name: "switch without default", src: "switch { case x: return }", want: false},
+		{name: "select", src: "select { case <-c: return }", want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file, err := parser.ParseFile(token.NewFileSet(), "sample.go", "package sample\nfunc f() {\n"+tc.src+"\n}", 0)
+			if err != nil {
+				t.Fatalf("failed to parse: %v", err)
+			}
+			stmt := file.Decls[0].(*ast.FuncDecl).Body.List[0]
+			if got := Terminates(stmt); got != tc.want {
+				t.Fatalf("Terminates(%s) = %v, want %v", tc.src, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Config holds the settings that can be supplied through a configuration file.
+// Values given on the command line are appended to the ones loaded from the file,
+// and options enabled in either place are enabled.
+type Config struct {
+	// TestArgs are passed to every `go test` invocation, e.g. -tags, -race or -count=1.
+	TestArgs []string `json:"testArgs"`
+	// Env holds KEY=VALUE pairs added to the environment of every `go test` invocation.
+	Env []string `json:"env"`
+	// FormatStrings enables mutating the format strings of printf-style calls.
+	FormatStrings bool `json:"formatStrings"`
+	// Mutators limits mutation to mutators whose names start with one of these prefixes, e.g. "Nil_".
+	Mutators []string `json:"mutators"`
+	// Race tests mutations of concurrency mutators, such as removed locks, with the race detector.
+	Race bool `json:"race"`
+}
+
+// Load reads a JSON configuration file.
+func Load(path string) (Config, error) {
+	var cfg Config
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("config %s: %w", path, err)
+	}
+	for _, kv := range cfg.Env {
+		if !strings.Contains(kv, "=") {
+			return cfg, fmt.Errorf("config %s: env entry %q is not of the form KEY=VALUE", path, kv)
+		}
+	}
+	return cfg, nil
+}
+
+// SplitArgs splits a command line string into arguments the way a POSIX shell would,
+// honouring single quotes, double quotes and backslash escapes.
+// `-ldflags="-X main.version=1"` becomes the single argument `-ldflags=-X main.version=1`.
+// Within double quotes a backslash only escapes $, `, ", \ and newline, so `"Foo\d"` keeps it.
+func SplitArgs(s string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			switch {
+			case r == '\n':
+				// line continuation
+			case quote == '"' && !strings.ContainsRune("$`\"\\", r):
+				current.WriteRune('\\')
+				current.WriteRune(r)
+			default:
+				current.WriteRune(r)
+			}
+			escaped = false
+			inArg = inArg || r != '\n'
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\\':
+			escaped = true
+		case quote == '"':
+			if r == '"' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if escaped {
+		return nil, fmt.Errorf("unterminated escape in %q", s)
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in %q", quote, s)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// BuildTags extracts the build tags from a list of `go test` arguments.
+// Both `-tags=a,b` and `-tags a,b` are recognised, as is the legacy space-separated list.
+func BuildTags(args []string) []string {
+	var tags []string
+	for i := 0; i < len(args); i++ {
+		if !strings.HasPrefix(args[i], "-") {
+			continue
+		}
+		arg := strings.TrimPrefix(strings.TrimPrefix(args[i], "-"), "-")
+
+		var value string
+		switch {
+		case strings.HasPrefix(arg, "tags="):
+			value = strings.TrimPrefix(arg, "tags=")
+		case arg == "tags" && i+1 < len(args):
+			i++
+			value = args[i]
+		default:
+			continue
+		}
+
+		// A later -tags flag overrides an earlier one, mirroring the go command.
+		tags = strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' })
+	}
+	return tags
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSplitArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{
+			name: "empty",
+			in:   "",
+			want: nil,
+		},
+		{
+			name: "plain flags",
+			in:   "-race  -count=1\t-short",
+			want: []string{"-race", "-count=1", "-short"},
+		},
+		{
+			name: "double quoted value",
+			in:   `-ldflags="-X main.version=1" -run TestFoo`,
+			want: []string{"-ldflags=-X main.version=1", "-run", "TestFoo"},
+		},
+		{
+			name: "single quoted value keeps backslashes",
+			in:   `-run 'Test\d+'`,
+			want: []string{"-run", `Test\d+`},
+		},
+		{
+			name: "escaped space",
+			in:   `-tags=a\ b`,
+			want: []string{"-tags=a b"},
+		},
+		{
+			name: "double quoted backslash before an ordinary character is kept",
+			in:   `-run "Foo\d" -ldflags="-X \"main.v=\$1\\"`,
+			want: []string{"-run", `Foo\d`, `-ldflags=-X "main.v=$1\`},
+		},
+		{
+			name: "escaped newline continues the line",
+			in:   "-race \\\n -short",
+			want: []string{"-race", "-short"},
+		},
+		{
+			name: "empty quoted argument",
+			in:   `-run ""`,
+			want: []string{"-run", ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SplitArgs(tt.in)
+			if err != nil {
+				t.Fatalf("SplitArgs(%q) returned error: %v", tt.in, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("SplitArgs(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitArgsUnterminated(t *testing.T) {
+	for _, in := range []string{`-run "Test`, `-run 'Test`, `-run Test\`} {
+		if _, err := SplitArgs(in); err == nil {
+			t.Fatalf("SplitArgs(%q) expected error, got nil", in)
+		}
+	}
+}
+
+func TestBuildTags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "no tags",
+			args: []string{"-race", "-count=1"},
+			want: nil,
+		},
+		{
+			name: "equals form",
+			args: []string{"-tags=integration,linux"},
+			want: []string{"integration", "linux"},
+		},
+		{
+			name: "separate value",
+			args: []string{"-race", "-tags", "integration"},
+			want: []string{"integration"},
+		},
+		{
+			name: "double dash and legacy spaces",
+			args: []string{"--tags=a b"},
+			want: []string{"a", "b"},
+		},
+		{
+			name: "last flag wins",
+			args: []string{"-tags=a", "-tags=b"},
+			want: []string{"b"},
+		},
+		{
+			name: "non-flag argument is ignored",
+			args: []string{"tags", "x"},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildTags(tt.args)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("BuildTags(%q) = %q, want %q", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "axiom.json")
+	content := `{"testArgs": ["-race", "-tags=integration"], "env": ["CGO_ENABLED=1"], "formatStrings": true, "mutators": ["Nil_"], "race": true}`
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if want := []string{"-race", "-tags=integration"}; !reflect.DeepEqual(cfg.TestArgs, want) {
+		t.Fatalf("TestArgs = %q, want %q", cfg.TestArgs, want)
+	}
+	if want := []string{"CGO_ENABLED=1"}; !reflect.DeepEqual(cfg.Env, want) {
+		t.Fatalf("Env = %q, want %q", cfg.Env, want)
+	}
+	if !cfg.FormatStrings {
+		t.Fatal("FormatStrings = false, want true")
+	}
+	if want := []string{"Nil_"}; !reflect.DeepEqual(cfg.Mutators, want) {
+		t.Fatalf("Mutators = %q, want %q", cfg.Mutators, want)
+	}
+	if !cfg.Race {
+		t.Fatal("Race = false, want true")
+	}
+}
+
+func TestLoadRejectsInvalidEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "axiom.json")
+	if err := os.WriteFile(path, []byte(`{"env": ["NOVALUE"]}`), 0o644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	if _, err := Load(path); err == nil {
+		t.Fatal("expected error for env entry without '='")
+	}
+}
@@ -1,15 +1,21 @@
 package generator
 
 import (
+	"errors"
+	"fmt"
 	"go/ast"
-	"go/importer"
+	"go/build"
 	"go/parser"
 	"go/token"
 	"go/types"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/loader"
 	"github.com/renja-g/axiom/internal/model"
+	"github.com/renja-g/axiom/internal/workspace"
 	"github.com/renja-g/axiom/mutator"
 )
 
@@ -19,12 +25,17 @@ type Generator struct {
 	pathMapper        func(string) string
 	needsTypeCheck    bool
 	typeAwareMutators []mutator.TypeAwareMutator
+	buildTags         []string
+	env               []string
+	loader            *loader.Loader
+	warnings          []string
 }
 
 func New(registry *mutator.Registry) *Generator {
 	g := &Generator{
 		registry:   registry,
 		pathMapper: func(p string) string { return p },
+		loader:     loader.New(),
 	}
 
 	// Check if any mutators need type information
@@ -45,31 +56,45 @@ func (g *Generator) WithPathMapper(mapper func(string) string) {
 	}
 }
 
+// WithBuildTags sets the build tags used to select which files belong to a package.
+func (g *Generator) WithBuildTags(tags []string) {
+	g.buildTags = tags
+}
+
+// WithEnv sets KEY=VALUE pairs (GOOS, GOARCH, CGO_ENABLED) that select which files belong to a package.
+func (g *Generator) WithEnv(env []string) {
+	g.env = env
+}
+
+// WithLoader sets the package loader used to resolve imports during type checking,
+// allowing it to be shared with other components.
+func (g *Generator) WithLoader(l *loader.Loader) {
+	if l != nil {
+		g.loader = l
+	}
+}
+
 // Discover walks a directory recursively and returns all discovered mutations.
+// Files are grouped into packages honouring build constraints; mutations in files
+// excluded by the current build configuration are reported as NotCompiled.
 func (g *Generator) Discover(rootDir string) ([]model.Mutation, error) {
 	var mutations []model.Mutation
-
-	// Group files by package directory for proper type checking
-	pkgFiles := make(map[string][]string)
+	var pkgDirs []string
+	g.warnings = nil
 
 	walkFn := func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
-		if info.IsDir() {
-			// skip vendor and hidden dirs
-			base := filepath.Base(path)
-			if base == "vendor" || len(base) > 0 && base[0] == '.' {
-				return filepath.SkipDir
-			}
+		if !info.IsDir() {
 			return nil
 		}
-		if filepath.Ext(path) != ".go" {
-			return nil
+		// skip vendor, testdata, hidden and underscore dirs like the go command does
+		base := filepath.Base(path)
+		if path != rootDir && (base == "vendor" || base == "testdata" || base[0] == '.' || base[0] == '_') {
+			return filepath.SkipDir
 		}
-
-		pkgDir := filepath.Dir(path)
-		pkgFiles[pkgDir] = append(pkgFiles[pkgDir], path)
+		pkgDirs = append(pkgDirs, path)
 		return nil
 	}
 
@@ -77,18 +102,95 @@ func (g *Generator) Discover(rootDir string) ([]model.Mutation, error) {
 		return nil, err
 	}
 
+	buildCtx := g.buildContext()
+
+	// Modules (nested go.mod files or go.work use directives) below the root.
+	// When there are none, the root lies inside a single enclosing module.
+	modules, err := workspace.Find(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.needsTypeCheck {
+		// Resolve the import graph so type checking sees sibling and third-party packages.
+		// Without a module type checking falls back to the default importer. A go list failure
+		// in a module does too, but is reported as a warning since type-aware mutators may then
+		// miss or misjudge mutations.
+		g.loader.WithBuildTags(g.buildTags)
+		g.loader.WithEnv(g.env)
+		if len(modules) == 0 {
+			_ = g.loader.Load(rootDir)
+		}
+		for _, mod := range modules {
+			if err := g.loader.Load(mod.Dir); err != nil {
+				g.warnings = append(g.warnings, fmt.Sprintf("module %s: type checking falls back to the default importer: %v", mod.Path, err))
+			}
+		}
+	}
+
 	// Process each package
-	for pkgDir, files := range pkgFiles {
-		pkgMutations, err := g.discoverInPackage(pkgDir, files)
+	for _, pkgDir := range pkgDirs {
+		start := len(mutations)
+		pkg, err := buildCtx.ImportDir(pkgDir, 0)
 		if err != nil {
-			return nil, err
+			var noGoErr *build.NoGoError
+			if !errors.As(err, &noGoErr) {
+				return nil, err
+			}
+		}
+
+		files := joinPaths(pkgDir, pkg.GoFiles, pkg.CgoFiles)
+		if len(files) > 0 {
+			pkgMutations, err := g.discoverInPackage(pkgDir, files)
+			if err != nil {
+				return nil, err
+			}
+			mutations = append(mutations, pkgMutations...)
+		}
+
+		var excluded []string
+		for _, name := range pkg.IgnoredGoFiles {
+			if !strings.HasSuffix(name, "_test.go") {
+				excluded = append(excluded, filepath.Join(pkgDir, name))
+			}
+		}
+		mutations = append(mutations, g.discoverNotCompiled(excluded)...)
+
+		if mod, ok := workspace.Owner(modules, pkgDir); ok {
+			for i := start; i < len(mutations); i++ {
+				mutations[i].Module = mod.Path
+				mutations[i].ModuleDir = g.pathMapper(mod.Dir)
+			}
 		}
-		mutations = append(mutations, pkgMutations...)
 	}
 
 	return mutations, nil
 }
 
+// Warnings returns the problems Discover ran into that didn't stop discovery,
+// such as a module whose packages couldn't be loaded for type checking.
+func (g *Generator) Warnings() []string {
+	return g.warnings
+}
+
+// buildContext returns the build context used to evaluate build constraints.
+func (g *Generator) buildContext() build.Context {
+	ctx := build.Default
+	ctx.BuildTags = g.buildTags
+	for _, kv := range g.env {
+		key, value, _ := strings.Cut(kv, "=")
+		switch key {
+		case "GOOS":
+			ctx.GOOS = value
+		case "GOARCH":
+			ctx.GOARCH = value
+		case "CGO_ENABLED":
+			ctx.CgoEnabled = value == "1"
+		}
+	}
+	return ctx
+}
+
 // discoverInPackage processes all files in a package together for proper type checking
 func (g *Generator) discoverInPackage(pkgDir string, filePaths []string) ([]model.Mutation, error) {
 	var mutations []model.Mutation
@@ -110,77 +212,91 @@ func (g *Generator) discoverInPackage(pkgDir string, filePaths []string) ([]mode
 	// Perform type checking at package level if needed
 	var typeInfo *types.Info
 	if g.needsTypeCheck {
-		typeInfo = g.performTypeCheckPackage(fset, astFiles)
+		typeInfo = g.performTypeCheckPackage(fset, pkgDir, astFiles)
 		// If type checking fails, we can still use non-type-aware mutators
 	}
 
 	// Inspect each file for mutations
 	for _, astFile := range astFiles {
-		filePath := fileMap[astFile]
-
-		ast.Inspect(astFile, func(n ast.Node) bool {
-			if n == nil {
-				return true
-			}
-			for _, m := range g.registry.GetMutators() {
-				canMutate := false
-
-				// Check if this is a type-aware mutator and we have type info
-				if tm, ok := m.(mutator.TypeAwareMutator); ok && typeInfo != nil {
-					canMutate = tm.CanMutateWithType(n, typeInfo)
-				} else {
-					// Fall back to regular CanMutate
-					canMutate = m.CanMutate(n)
-				}
-
-				if canMutate {
-					if bin, ok := n.(*ast.BinaryExpr); ok {
-						pos := fset.Position(bin.OpPos)
-						mutations = append(mutations, model.Mutation{
-							FilePath:   g.pathMapper(filePath),
-							Line:       pos.Line,
-							Column:     pos.Column,
-							Mutator:    m,
-							OriginalOp: bin.Op,
-						})
-					}
-				}
-			}
-			return true
-		})
+		mutations = append(mutations, g.inspectFile(fset, astFile, fileMap[astFile], typeInfo, false)...)
 	}
 
 	return mutations, nil
 }
 
-// performTypeCheckPackage runs the type checker on a package and returns type information.
-// Returns nil if type checking fails (allows graceful degradation).
-func (g *Generator) performTypeCheckPackage(fset *token.FileSet, astFiles []*ast.File) *types.Info {
-	if len(astFiles) == 0 {
-		return nil
+// discoverNotCompiled collects mutations in files excluded by build constraints.
+// These files are never compiled, so their mutations are marked NotCompiled instead of being tested.
+// Files that fail to parse are skipped since they cannot affect the build either.
+func (g *Generator) discoverNotCompiled(filePaths []string) []model.Mutation {
+	var mutations []model.Mutation
+	for _, path := range filePaths {
+		fset := token.NewFileSet()
+		astFile, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			continue
+		}
+		mutations = append(mutations, g.inspectFile(fset, astFile, path, nil, true)...)
 	}
+	return mutations
+}
 
-	info := &types.Info{
-		Types: make(map[ast.Expr]types.TypeAndValue),
-	}
+// inspectFile walks a single file and records every applicable mutation.
+func (g *Generator) inspectFile(fset *token.FileSet, astFile *ast.File, filePath string, typeInfo *types.Info, notCompiled bool) []model.Mutation {
+	var mutations []model.Mutation
 
-	conf := types.Config{
-		Importer: importer.Default(),
-		Error:    func(err error) {}, // Ignore type errors to allow partial type info
-	}
+	ast.Inspect(astFile, func(n ast.Node) bool {
+		if n == nil {
+			return true
+		}
+		for _, m := range g.registry.GetMutators() {
+			canMutate := false
 
-	// Use the package name from the first file
-	pkgName := astFiles[0].Name.Name
+			// Check if this is a type-aware mutator and we have type info
+			if tm, ok := m.(mutator.TypeAwareMutator); ok && typeInfo != nil {
+				canMutate = tm.CanMutateWithType(n, typeInfo)
+			} else {
+				// Fall back to regular CanMutate
+				canMutate = m.CanMutate(n)
+			}
 
-	_, err := conf.Check(pkgName, fset, astFiles, info)
-	if err != nil {
-		// Type checking had errors, but we might still have partial type info
-		// Return the info anyway as it may be useful
-		if len(info.Types) > 0 {
-			return info
+			if canMutate {
+				pos := fset.Position(astutil.Pos(n))
+				end := fset.Position(n.End())
+				mutation := model.Mutation{
+					FilePath:    g.pathMapper(filePath),
+					Line:        pos.Line,
+					Column:      pos.Column,
+					EndLine:     end.Line,
+					EndColumn:   end.Column,
+					NodeType:    astutil.Kind(n),
+					Mutator:     m,
+					NotCompiled: notCompiled,
+				}
+				if bin, ok := n.(*ast.BinaryExpr); ok {
+					mutation.OriginalOp = bin.Op
+				}
+				mutations = append(mutations, mutation)
+			}
+		}
+		return true
+	})
+
+	return mutations
+}
+
+func joinPaths(dir string, nameLists ...[]string) []string {
+	var paths []string
+	for _, names := range nameLists {
+		for _, name := range names {
+			paths = append(paths, filepath.Join(dir, name))
 		}
-		return nil
 	}
+	return paths
+}
 
-	return info
+// performTypeCheckPackage runs the type checker on a package and returns type information.
+// Dependencies are resolved through the loader's export data when the package was loaded.
+// Returns nil if type checking fails (allows graceful degradation).
+func (g *Generator) performTypeCheckPackage(fset *token.FileSet, pkgDir string, astFiles []*ast.File) *types.Info {
+	return g.loader.TypeCheck(fset, pkgDir, astFiles)
 }
@@ -70,6 +70,7 @@ func TestDiscoverAppliesPathMapperAndCollectsMutations(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Discover returned error: %v", err)
 	}
+	mutations = byMutator(mutations, "ConditionalBoundary_GTR_GEQ")
 
 	if len(mutations) != 1 {
 		t.Fatalf("expected 1 mutation, got %d", len(mutations))
@@ -131,3 +132,248 @@ func TestDiscoverTypeAwareMutatorsUseTypeInfo(t *testing.T) {
 		t.Fatalf("expected addition mutation on line 8, got line %d", addMutation.Line)
 	}
 }
+
+func TestDiscoverHonoursBuildTags(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"sample.go":      "package sample\n\nfunc cmp(a, b int) bool {\n\treturn a > b\n}\n",
+		"integration.go": "//go:build integration\n\npackage sample\n\nfunc other(a, b int) bool {\n\treturn a > b\n}\n",
+	}
+	for name, source := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(source), 0o644); err != nil {
+			t.Fatalf("failed to write source file: %v", err)
+		}
+	}
+
+	gen := New(mutator.NewRegistry())
+
+	mutations, err := gen.Discover(dir)
+	if err != nil {
+		t.Fatalf("Discover returned error: %v", err)
+	}
+	mutations = byMutator(mutations, "ConditionalBoundary_GTR_GEQ")
+	if compiled, excluded := countCompiled(mutations); compiled != 1 || excluded != 1 {
+		t.Fatalf("expected 1 compiled and 1 not compiled mutation without build tags, got %d and %d", compiled, excluded)
+	}
+
+	gen.WithBuildTags([]string{"integration"})
+
+	mutations, err = gen.Discover(dir)
+	if err != nil {
+		t.Fatalf("Discover returned error: %v", err)
+	}
+	mutations = byMutator(mutations, "ConditionalBoundary_GTR_GEQ")
+	if compiled, excluded := countCompiled(mutations); compiled != 2 || excluded != 0 {
+		t.Fatalf("expected 2 compiled mutations with the integration tag, got %d compiled and %d not compiled", compiled, excluded)
+	}
+}
+
+func TestDiscoverHonoursGOOSAndMarksExcludedFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"sample.go":         "package sample\n\nfunc cmp(a, b int) bool {\n\treturn a > b\n}\n",
+		"sample_windows.go": "package sample\n\nfunc win(a, b int) bool {\n\treturn a > b\n}\n",
+		"generate.go":       "//go:build ignore\n\npackage main\n\nfunc main() {\n\tprintln(1 > 2)\n}\n",
+		"sample_test.go":    "package sample\n\nfunc helper(a, b int) bool {\n\treturn a > b\n}\n",
+	}
+	for name, source := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(source), 0o644); err != nil {
+			t.Fatalf("failed to write source file: %v", err)
+		}
+	}
+
+	gen := New(mutator.NewRegistry())
+	gen.WithEnv([]string{"GOOS=linux", "GOARCH=amd64"})
+
+	mutations, err := gen.Discover(dir)
+	if err != nil {
+		t.Fatalf("Discover returned error: %v", err)
+	}
+
+	byFile := make(map[string]model.Mutation)
+	for _, m := range mutations {
+		byFile[filepath.Base(m.FilePath)] = m
+	}
+
+	if _, ok := byFile["sample_test.go"]; ok {
+		t.Fatalf("test files should not be mutated")
+	}
+	if m, ok := byFile["sample.go"]; !ok || m.NotCompiled {
+		t.Fatalf("expected compiled mutation in sample.go, got %+v (found=%v)", m, ok)
+	}
+	for _, name := range []string{"sample_windows.go", "generate.go"} {
+		if m, ok := byFile[name]; !ok || !m.NotCompiled {
+			t.Fatalf("expected not compiled mutation in %s, got %+v (found=%v)", name, m, ok)
+		}
+	}
+
+	gen.WithEnv([]string{"GOOS=windows"})
+
+	mutations, err = gen.Discover(dir)
+	if err != nil {
+		t.Fatalf("Discover returned error: %v", err)
+	}
+	for _, m := range mutations {
+		if filepath.Base(m.FilePath) == "sample_windows.go" && m.NotCompiled {
+			t.Fatalf("expected sample_windows.go to be compiled with GOOS=windows")
+		}
+	}
+}
+
+func TestDiscoverTypeChecksAgainstSiblingPackages(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":               "module example.com/genfixture\n\ngo 1.21\n",
+		"names/names.go":       "package names\n\ntype Name string\n",
+		"consumer/consumer.go": "package consumer\n\nimport \"example.com/genfixture/names\"\n\nfunc Greet(n names.Name) names.Name {\n\treturn n + \"!\"\n}\n",
+	}
+	for name, source := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(source), 0o644); err != nil {
+			t.Fatalf("failed to write source file: %v", err)
+		}
+	}
+
+	gen := New(mutator.NewRegistry())
+
+	mutations, err := gen.Discover(dir)
+	if err != nil {
+		t.Fatalf("Discover returned error: %v", err)
+	}
+
+	for _, m := range mutations {
+		if m.Mutator.Name() == "Arithmetic_ADD" {
+			t.Fatalf("string concatenation of a sibling package type should not be mutated: %+v", m)
+		}
+	}
+}
+
+func TestDiscoverWarnsWhenModuleFailsToLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"go.mod":    "module example.com/broken\n\ngo 1.21\n\nbogus directive\n",
+		"sample.go": "package sample\n\nfunc Sum(a, b int) int {\n\treturn a + b\n}\n",
+	}
+	for name, source := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(source), 0o644); err != nil {
+			t.Fatalf("failed to write source file: %v", err)
+		}
+	}
+
+	gen := New(mutator.NewRegistry())
+
+	if _, err := gen.Discover(dir); err != nil {
+		t.Fatalf("Discover returned error: %v", err)
+	}
+	warnings := gen.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "example.com/broken") {
+		t.Fatalf("expected one warning naming the module, got %q", warnings)
+	}
+}
+
+func TestDiscoverAssignsMutationsToModules(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"go.work":      "go 1.21\n\nuse (\n\t./a\n\t./b\n)\n",
+		"a/go.mod":     "module example.com/a\n\ngo 1.21\n",
+		"a/a.go":       "package a\n\nfunc Cmp(x, y int) bool {\n\treturn x > y\n}\n",
+		"b/go.mod":     "module example.com/b\n\ngo 1.21\n",
+		"b/inner/b.go": "package inner\n\nfunc Cmp(x, y int) bool {\n\treturn x > y\n}\n",
+	}
+	for name, source := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(source), 0o644); err != nil {
+			t.Fatalf("failed to write source file: %v", err)
+		}
+	}
+
+	gen := New(mutator.NewRegistry())
+
+	mutations, err := gen.Discover(dir)
+	if err != nil {
+		t.Fatalf("Discover returned error: %v", err)
+	}
+	mutations = byMutator(mutations, "ConditionalBoundary_GTR_GEQ")
+	if len(mutations) != 2 {
+		t.Fatalf("expected 2 mutations, got %d", len(mutations))
+	}
+
+	want := map[string]model.Mutation{
+		"a.go": {Module: "example.com/a", ModuleDir: filepath.Join(dir, "a")},
+		"b.go": {Module: "example.com/b", ModuleDir: filepath.Join(dir, "b")},
+	}
+	for _, m := range mutations {
+		w := want[filepath.Base(m.FilePath)]
+		if m.Module != w.Module || m.ModuleDir != w.ModuleDir {
+			t.Fatalf("mutation in %s assigned to module %q (%s), want %q (%s)", m.FilePath, m.Module, m.ModuleDir, w.Module, w.ModuleDir)
+		}
+	}
+}
+
+func TestDiscoverRecordsNonBinaryNodes(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "sample.go")
+
+	source := "package sample\n\nfunc count(xs []int) int {\n\tn := 0\n\tfor range xs {\n\t\tn++\n\t}\n\treturn n\n}\n"
+
+	if err := os.WriteFile(filePath, []byte(source), 0o644); err != nil {
+		t.Fatalf("failed to write source file: %v", err)
+	}
+
+	gen := New(mutator.NewRegistry())
+
+	mutations, err := gen.Discover(dir)
+	if err != nil {
+		t.Fatalf("Discover returned error: %v", err)
+	}
+
+	var deletion *model.Mutation
+	for i := range mutations {
+		if mutations[i].Mutator.Name() == "Statement_DELETE" {
+			deletion = &mutations[i]
+		}
+	}
+	if deletion == nil {
+		t.Fatalf("expected a statement deletion mutation for n++")
+	}
+
+	if deletion.NodeType != "*ast.IncDecStmt" {
+		t.Fatalf("NodeType = %q, want %q", deletion.NodeType, "*ast.IncDecStmt")
+	}
+	if deletion.Line != 6 || deletion.Column != 4 || deletion.EndLine != 6 || deletion.EndColumn != 6 {
+		t.Fatalf("unexpected position %d:%d-%d:%d, want 6:4-6:6", deletion.Line, deletion.Column, deletion.EndLine, deletion.EndColumn)
+	}
+}
+
+func countCompiled(mutations []model.Mutation) (compiled, notCompiled int) {
+	for _, m := range mutations {
+		if m.NotCompiled {
+			notCompiled++
+		} else {
+			compiled++
+		}
+	}
+	return compiled, notCompiled
+}
+
+// byMutator returns the mutations produced by the named mutator.
+func byMutator(mutations []model.Mutation, name string) []model.Mutation {
+	var filtered []model.Mutation
+	for _, m := range mutations {
+		if m.Mutator.Name() == name {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,171 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Package is the subset of `go list -json` output needed to type-check a package.
+type Package struct {
+	ImportPath string
+	Name       string
+	Dir        string
+	Export     string
+	GoFiles    []string
+	CgoFiles   []string
+	ImportMap  map[string]string
+}
+
+// Loader resolves packages through `go list -export` so that type checking uses
+// compiler export data for every dependency: sibling packages in the same module,
+// third-party modules and the standard library alike.
+type Loader struct {
+	buildTags []string
+	env       []string
+	byPath    map[string]*Package
+	byDir     map[string]*Package
+}
+
+func New() *Loader {
+	return &Loader{
+		byPath: make(map[string]*Package),
+		byDir:  make(map[string]*Package),
+	}
+}
+
+// WithBuildTags sets the build tags passed to `go list`.
+func (l *Loader) WithBuildTags(tags []string) {
+	l.buildTags = tags
+}
+
+// WithEnv sets KEY=VALUE pairs added to the environment of `go list`.
+func (l *Loader) WithEnv(env []string) {
+	l.env = env
+}
+
+// Load lists every package below dir together with its dependencies and records
+// where their export data lives. It can be called for several directories; results accumulate.
+func (l *Loader) Load(dir string) error {
+	args := []string{"list", "-e", "-export", "-deps", "-json=ImportPath,Name,Dir,Export,GoFiles,CgoFiles,ImportMap"}
+	if len(l.buildTags) > 0 {
+		args = append(args, "-tags="+strings.Join(l.buildTags, ","))
+	}
+	args = append(args, "./...")
+
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	if len(l.env) > 0 {
+		cmd.Env = append(os.Environ(), l.env...)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("go list in %s: %w: %s", dir, err, strings.TrimSpace(stderr.String()))
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for {
+		var pkg Package
+		if err := dec.Decode(&pkg); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("go list in %s: %w", dir, err)
+		}
+		l.byPath[pkg.ImportPath] = &pkg
+		if pkg.Dir != "" {
+			l.byDir[filepath.Clean(pkg.Dir)] = &pkg
+		}
+	}
+}
+
+// Files returns the absolute paths of the files compiled into the package.
+func (p *Package) Files() []string {
+	var files []string
+	for _, names := range [][]string{p.GoFiles, p.CgoFiles} {
+		for _, name := range names {
+			files = append(files, filepath.Join(p.Dir, name))
+		}
+	}
+	return files
+}
+
+// PackageForDir returns the loaded package whose sources live in dir.
+func (l *Loader) PackageForDir(dir string) (*Package, bool) {
+	pkg, ok := l.byDir[filepath.Clean(dir)]
+	return pkg, ok
+}
+
+// TypeCheck type-checks the given files of the package in pkgDir and returns the collected type information.
+// Packages that were not loaded fall back to the default importer, which only resolves the standard library.
+// Returns nil if type checking produced no information at all (allows graceful degradation).
+func (l *Loader) TypeCheck(fset *token.FileSet, pkgDir string, astFiles []*ast.File) *types.Info {
+	if len(astFiles) == 0 {
+		return nil
+	}
+
+	info := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Implicits:  make(map[ast.Node]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		Scopes:     make(map[ast.Node]*types.Scope),
+		// FileVersions records every checked file, letting mutators find the file enclosing a node
+		FileVersions: make(map[*ast.File]string),
+	}
+
+	// Use the package name from the first file unless the import path is known
+	pkgPath := astFiles[0].Name.Name
+	var imp types.Importer = importer.Default()
+	if l != nil {
+		if pkg, ok := l.PackageForDir(pkgDir); ok {
+			pkgPath = pkg.ImportPath
+			imp = l.importerFor(fset, pkg)
+		}
+	}
+
+	conf := types.Config{
+		Importer: imp,
+		Error:    func(err error) {}, // Ignore type errors to allow partial type info
+	}
+
+	_, err := conf.Check(pkgPath, fset, astFiles, info)
+	if err != nil {
+		// Type checking had errors, but we might still have partial type info
+		// Return the info anyway as it may be useful
+		if len(info.Types) > 0 {
+			return info
+		}
+		return nil
+	}
+
+	return info
+}
+
+// importerFor returns an importer reading export data for the dependencies of pkg.
+func (l *Loader) importerFor(fset *token.FileSet, pkg *Package) types.Importer {
+	return importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		// resolve vendored or otherwise remapped import paths
+		if mapped, ok := pkg.ImportMap[path]; ok {
+			path = mapped
+		}
+		dep, ok := l.byPath[path]
+		if !ok || dep.Export == "" {
+			return nil, fmt.Errorf("no export data for %q", path)
+		}
+		return os.Open(dep.Export)
+	})
+}
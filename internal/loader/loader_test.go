@@ -0,0 +1,102 @@
+package loader
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadAndTypeCheckSiblingPackage(t *testing.T) {
+	root := newModule(t)
+
+	l := New()
+	if err := l.Load(root); err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	consumerDir := filepath.Join(root, "consumer")
+	pkg, ok := l.PackageForDir(consumerDir)
+	if !ok {
+		t.Fatalf("expected package for %s to be loaded", consumerDir)
+	}
+	if pkg.ImportPath != "example.com/loaderfixture/consumer" {
+		t.Fatalf("ImportPath = %q, want %q", pkg.ImportPath, "example.com/loaderfixture/consumer")
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filepath.Join(consumerDir, "consumer.go"), nil, 0)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	info := l.TypeCheck(fset, consumerDir, []*ast.File{file})
+	if info == nil {
+		t.Fatal("expected type information, got nil")
+	}
+
+	var bin *ast.BinaryExpr
+	ast.Inspect(file, func(n ast.Node) bool {
+		if b, ok := n.(*ast.BinaryExpr); ok {
+			bin = b
+		}
+		return bin == nil
+	})
+	if bin == nil {
+		t.Fatal("binary expression not found")
+	}
+
+	typ := info.TypeOf(bin)
+	if typ == nil {
+		t.Fatal("expected type for expression using sibling package")
+	}
+	if got := types.TypeString(typ, nil); got != "example.com/loaderfixture/names.Name" {
+		t.Fatalf("TypeOf = %q, want %q", got, "example.com/loaderfixture/names.Name")
+	}
+}
+
+func TestTypeCheckWithoutLoadFallsBackToDefaultImporter(t *testing.T) {
+	fset := token.NewFileSet()
+	src := "package sample\n\nimport \"strings\"\n\nfunc f() string { return strings.ToUpper(\"a\") + \"b\" }\n"
+	file, err := parser.ParseFile(fset, "sample.go", src, 0)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+
+	info := New().TypeCheck(fset, t.TempDir(), []*ast.File{file})
+	if info == nil {
+		t.Fatal("expected type information, got nil")
+	}
+	if len(info.Uses) == 0 {
+		t.Fatal("expected Uses to be recorded")
+	}
+}
+
+func newModule(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/loaderfixture\n\ngo 1.21\n")
+	writeFile(t, filepath.Join(root, "names", "names.go"), "package names\n\ntype Name string\n")
+	writeFile(t, filepath.Join(root, "consumer", "consumer.go"), `package consumer
+
+import "example.com/loaderfixture/names"
+
+func Greet(n names.Name) names.Name {
+	return n + "!"
+}
+`)
+	return root
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("failed to create directories for %s: %v", path, err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write file %s: %v", path, err)
+	}
+}
@@ -8,12 +8,25 @@ import (
 )
 
 // Mutation describes a specific change to be applied at a location.
+// Line and Column point at the operator for binary expressions, assignments and
+// inc/dec statements, and at the start of the node otherwise. Together with the
+// node type and end position they identify the mutated node when the file is re-parsed.
 type Mutation struct {
 	FilePath   string
 	Line       int
 	Column     int
+	EndLine    int
+	EndColumn  int
+	NodeType   string // e.g. "*ast.ExprStmt"
 	Mutator    mutator.Mutator
 	OriginalOp token.Token // for BinaryExpr cases
+	// NotCompiled marks mutations in files excluded by build constraints;
+	// they are never tested because the mutated code is not part of the build.
+	NotCompiled bool
+	// Module is the path of the module the file belongs to and ModuleDir its directory.
+	// Both are empty when the mutation root lies inside a single enclosing module.
+	Module    string
+	ModuleDir string
 }
 
 // Target ties a parsed file to its AST and fset for reuse.
@@ -2,28 +2,79 @@ package runner
 
 import (
 	"bytes"
+	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/printer"
 	"go/token"
+	"go/types"
 	"os"
 	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/loader"
 	"github.com/renja-g/axiom/internal/model"
 	"github.com/renja-g/axiom/internal/sandbox"
+	"github.com/renja-g/axiom/mutator"
 )
 
-// Runner applies mutations and runs tests inside a sandbox copy.
+// DefaultTimeout bounds the `go test` run of each mutation, since mutations such as a removed
+// loop exit or lock can make the tests hang.
+const DefaultTimeout = time.Minute
+
+// Runner applies mutations and runs tests inside a sandbox.
 type Runner struct {
-	sandbox *sandbox.Sandbox
+	sandbox  sandbox.Sandbox
+	loader   *loader.Loader
+	testArgs []string
+	env      []string
+	race     bool
+	timeout  time.Duration
+}
+
+func New(sb sandbox.Sandbox) *Runner { return &Runner{sandbox: sb, timeout: DefaultTimeout} }
+
+// WithLoader sets the package loader used to type-check packages for mutators
+// implementing mutator.TypeAwareRewriter. Without a loader those mutators fall back to Mutate.
+func (r *Runner) WithLoader(l *loader.Loader) {
+	r.loader = l
+}
+
+// WithTestArgs sets extra arguments (e.g. -tags, -race, -count=1) passed to every `go test` invocation.
+func (r *Runner) WithTestArgs(args []string) {
+	r.testArgs = args
 }
 
-func New(sb *sandbox.Sandbox) *Runner { return &Runner{sandbox: sb} }
+// WithEnv sets KEY=VALUE pairs added to the environment of every `go test` invocation.
+func (r *Runner) WithEnv(env []string) {
+	r.env = env
+}
+
+// WithRace enables the race detector for mutations of mutators implementing mutator.RaceMutator.
+// The race detector requires cgo.
+func (r *Runner) WithRace(enabled bool) {
+	r.race = enabled
+}
+
+// WithTimeout sets the -timeout of every `go test` invocation; a mutation whose tests exceed it is killed.
+// A timeout in the test arguments takes precedence, and zero leaves go test's own default.
+func (r *Runner) WithTimeout(d time.Duration) {
+	r.timeout = d
+}
 
 // TestMutation applies a single mutation, runs `go test` on the given package, restores the file, and returns the result.
 func (r *Runner) TestMutation(m model.Mutation, pkg string) (result model.Result, err error) {
 	result = model.Result{Mutation: m}
 
+	// mutations in files excluded from the build can't be observed by tests
+	if m.NotCompiled {
+		return result, nil
+	}
+
 	// determine sandbox path equivalent
 	path := m.FilePath
 	if r.sandbox != nil {
@@ -36,48 +87,79 @@ func (r *Runner) TestMutation(m model.Mutation, pkg string) (result model.Result
 		return result, rerr
 	}
 
-	// parse
+	// parse, keeping comments so build constraints and directives survive printing
 	fset := token.NewFileSet()
-	astFile, perr := parser.ParseFile(fset, path, original, 0)
+	astFile, perr := parser.ParseFile(fset, path, original, parser.ParseComments)
 	if perr != nil {
 		err = perr
 		return
 	}
 
 	// apply mutation
-	ast.Inspect(astFile, func(n ast.Node) bool {
-		bin, ok := n.(*ast.BinaryExpr)
-		if !ok {
-			return true
-		}
-		pos := fset.Position(bin.OpPos)
-		if pos.Line == m.Line && pos.Column == m.Column {
-			mut := m.Mutator.Mutate(n)
-			if mutatedBin, ok := mut.(*ast.BinaryExpr); ok {
-				bin.Op = mutatedBin.Op
-			}
-			return false
-		}
-		return true
-	})
+	target := findTarget(fset, astFile, m)
+	if target == nil {
+		err = fmt.Errorf("%s:%d:%d: no %s node to mutate", m.FilePath, m.Line, m.Column, m.Mutator.Name())
+		return
+	}
+	var replacement ast.Node
+	if rw, ok := m.Mutator.(mutator.TypeAwareRewriter); ok && r.loader != nil {
+		replacement = rw.MutateWithType(target, r.typeCheck(fset, path, astFile))
+	} else {
+		replacement = m.Mutator.Mutate(target)
+	}
+	if replacement == target {
+		// e.g. a type-aware rewriter without usable type information; the identical file would survive
+		err = fmt.Errorf("%s:%d:%d: %s left the %s unchanged", m.FilePath, m.Line, m.Column, m.Mutator.Name(), astutil.Kind(target))
+		return
+	}
+	if !astutil.Replace(astFile, target, replacement) {
+		err = fmt.Errorf("%s:%d:%d: cannot replace %s with %T", m.FilePath, m.Line, m.Column, astutil.Kind(target), replacement)
+		return
+	}
+	if im, ok := m.Mutator.(mutator.ImportingMutator); ok {
+		addImports(astFile, replacement, im.Imports())
+	}
 
 	// write mutated
 	var buf bytes.Buffer
-	printer.Fprint(&buf, fset, astFile)
-	if werr := os.WriteFile(path, buf.Bytes(), 0644); werr != nil {
+	if perr := printer.Fprint(&buf, fset, astFile); perr != nil {
+		err = fmt.Errorf("%s:%d:%d: printing mutated file: %w", m.FilePath, m.Line, m.Column, perr)
+		return
+	}
+	if werr := r.apply(path, buf.Bytes()); werr != nil {
 		err = werr
 		return
 	}
 	defer func() {
-		if restoreErr := os.WriteFile(path, original, 0644); err == nil && restoreErr != nil {
+		if restoreErr := r.revert(path, original); err == nil && restoreErr != nil {
 			err = restoreErr
 		}
 	}()
 
 	// run tests
-	cmd := exec.Command("go", "test", pkg)
-	if r.sandbox != nil {
+	args := append([]string{"test"}, r.testArgs...)
+	if r.needsRace(m.Mutator) {
+		args = append(args, "-race")
+	}
+	if r.needsTimeout() {
+		args = append(args, "-timeout="+r.timeout.String())
+	}
+	if flagger, ok := r.sandbox.(sandbox.GoFlagger); ok {
+		args = append(args, flagger.GoFlags()...)
+	}
+	args = append(args, pkg)
+	cmd := exec.Command("go", args...)
+	if len(r.env) > 0 {
+		cmd.Env = append(os.Environ(), r.env...)
+	}
+	// run from the module owning the file so multi-module repositories resolve correctly
+	switch {
+	case r.sandbox != nil && m.ModuleDir != "":
+		cmd.Dir = r.sandbox.MirrorPath(m.ModuleDir)
+	case r.sandbox != nil:
 		cmd.Dir = r.sandbox.Root()
+	case m.ModuleDir != "":
+		cmd.Dir = m.ModuleDir
 	}
 	output, cmdErr := cmd.CombinedOutput()
 	result.Output = string(output)
@@ -93,3 +175,101 @@ func (r *Runner) TestMutation(m model.Mutation, pkg string) (result model.Result
 	result.Killed = false
 	return
 }
+
+// needsRace reports whether m's mutations are tested with -race, which isn't repeated when
+// the test arguments already enable it.
+func (r *Runner) needsRace(m mutator.Mutator) bool {
+	rm, ok := m.(mutator.RaceMutator)
+	if !r.race || !ok || !rm.Race() {
+		return false
+	}
+	for _, arg := range r.testArgs {
+		if arg == "-race" || arg == "--race" || arg == "-race=true" {
+			return false
+		}
+	}
+	return true
+}
+
+// needsTimeout reports whether the runner's timeout is passed to go test, which it isn't when
+// the test arguments set one.
+func (r *Runner) needsTimeout() bool {
+	if r.timeout <= 0 {
+		return false
+	}
+	for _, arg := range r.testArgs {
+		if strings.HasPrefix(arg, "-timeout") || strings.HasPrefix(arg, "--timeout") {
+			return false
+		}
+	}
+	return true
+}
+
+// apply writes the mutated file, through the sandbox when there is one.
+func (r *Runner) apply(path string, data []byte) error {
+	if r.sandbox != nil {
+		return r.sandbox.Apply(path, data)
+	}
+	return os.WriteFile(path, data, 0644)
+}
+
+// revert restores the original file contents.
+func (r *Runner) revert(path string, original []byte) error {
+	if r.sandbox != nil {
+		return r.sandbox.Revert(path)
+	}
+	return os.WriteFile(path, original, 0644)
+}
+
+// findTarget returns the node a mutation refers to, identified by its position, end and node type.
+// Mutations without a recorded node type are matched by position and the mutator's CanMutate.
+func findTarget(fset *token.FileSet, astFile *ast.File, m model.Mutation) ast.Node {
+	var target ast.Node
+	ast.Inspect(astFile, func(n ast.Node) bool {
+		if target != nil || n == nil {
+			return false
+		}
+		pos := fset.Position(astutil.Pos(n))
+		if pos.Line != m.Line || pos.Column != m.Column {
+			return true
+		}
+		if m.NodeType != "" {
+			end := fset.Position(n.End())
+			if astutil.Kind(n) != m.NodeType || end.Line != m.EndLine || end.Column != m.EndColumn {
+				return true
+			}
+		} else if !m.Mutator.CanMutate(n) {
+			return true
+		}
+		target = n
+		return false
+	})
+	return target
+}
+
+// addImports adds the imports among paths that replacement references but astFile lacks.
+func addImports(astFile *ast.File, replacement ast.Node, paths []string) {
+	for _, imp := range paths {
+		if replacement != nil && !astutil.HasImport(astFile, imp) && astutil.UsesPackage(replacement, path.Base(imp)) {
+			astutil.AddImport(astFile, imp)
+		}
+	}
+}
+
+// typeCheck type-checks the package containing path, using astFile for path itself
+// so the returned information refers to the nodes being mutated.
+func (r *Runner) typeCheck(fset *token.FileSet, path string, astFile *ast.File) *types.Info {
+	dir := filepath.Dir(path)
+	files := []*ast.File{astFile}
+	if pkg, ok := r.loader.PackageForDir(dir); ok {
+		for _, other := range pkg.Files() {
+			if other == path {
+				continue
+			}
+			if f, err := parser.ParseFile(fset, other, nil, 0); err == nil {
+				files = append(files, f)
+			}
+		}
+	}
+	return r.loader.TypeCheck(fset, dir, files)
+}
@@ -6,10 +6,15 @@ import (
 	"go/token"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/renja-g/axiom/internal/loader"
 	"github.com/renja-g/axiom/internal/model"
 	"github.com/renja-g/axiom/internal/sandbox"
+	"github.com/renja-g/axiom/mutator/return_value"
+	"github.com/renja-g/axiom/mutator/statement"
 )
 
 type binaryOpMutator struct {
@@ -74,6 +79,450 @@ func TestRunnerTestMutationSurvives(t *testing.T) {
 	assertFileRestored(t, fx.sandboxPath, fx.originalContent)
 }
 
+func TestRunnerTestMutationPassesTestArgs(t *testing.T) {
+	fx := newRunnerFixture(t)
+	fx.runner.WithTestArgs([]string{"-count=1", "-run", "^$"})
+	mutation := model.Mutation{
+		FilePath: fx.filePath,
+		Line:     fx.line,
+		Column:   fx.column,
+		Mutator:  binaryOpMutator{name: "less-than", target: token.LSS},
+	}
+
+	result, err := fx.runner.TestMutation(mutation, ".")
+	if err != nil {
+		t.Fatalf("TestMutation returned error: %v", err)
+	}
+	if result.Killed {
+		t.Fatalf("expected mutation to survive when no tests are selected, got killed result: %+v", result)
+	}
+}
+
+func TestRunnerTestMutationPassesEnv(t *testing.T) {
+	fx := newRunnerFixture(t)
+	fx.runner.WithEnv([]string{"GOFLAGS=-v"})
+	mutation := model.Mutation{
+		FilePath: fx.filePath,
+		Line:     fx.line,
+		Column:   fx.column,
+		Mutator:  binaryOpMutator{name: "greater-equal", target: token.GEQ},
+	}
+
+	result, err := fx.runner.TestMutation(mutation, ".")
+	if err != nil {
+		t.Fatalf("TestMutation returned error: %v", err)
+	}
+	if !strings.Contains(result.Output, "=== RUN   TestCompare") {
+		t.Fatalf("expected verbose test output from GOFLAGS, got: %s", result.Output)
+	}
+}
+
+type raceMutator struct {
+	binaryOpMutator
+	race bool
+}
+
+func (m raceMutator) Race() bool { return m.race }
+
+func TestRunnerNeedsRace(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		testArgs []string
+		mutator  raceMutator
+		want     bool
+	}{
+		{name: "race mutator with race detection enabled", enabled: true, mutator: raceMutator{race: true}, want: true},
+		{name: "race detection disabled", enabled: false, mutator: raceMutator{race: true}, want: false},
+		{name: "mutator not needing races", enabled: true, mutator: raceMutator{race: false}, want: false},
+		{name: "race already in test args", enabled: true, testArgs: []string{"-count=1", "-race"}, mutator: raceMutator{race: true}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := New(nil)
+			r.WithRace(tc.enabled)
+			r.WithTestArgs(tc.testArgs)
+
+			if got := r.needsRace(tc.mutator); got != tc.want {
+				t.Fatalf("needsRace() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if New(nil).needsRace(binaryOpMutator{}) {
+		t.Fatalf("needsRace() = true for a mutator without Race")
+	}
+}
+
+func TestRunnerTestMutationPassesTimeout(t *testing.T) {
+	fx := newRunnerFixture(t)
+	fx.runner.WithTestArgs([]string{"-count=1"})
+	fx.runner.WithTimeout(time.Nanosecond)
+	mutation := model.Mutation{
+		FilePath: fx.filePath,
+		Line:     fx.line,
+		Column:   fx.column,
+		Mutator:  binaryOpMutator{name: "greater-equal", target: token.GEQ},
+	}
+
+	result, err := fx.runner.TestMutation(mutation, ".")
+	if err != nil {
+		t.Fatalf("TestMutation returned error: %v", err)
+	}
+	if !result.Killed || !strings.Contains(result.Output, "test timed out") {
+		t.Fatalf("expected the surviving mutation to be killed by the timeout, got: %+v", result)
+	}
+}
+
+func TestRunnerNeedsTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeout  time.Duration
+		testArgs []string
+		want     bool
+	}{
+		{name: "default timeout", timeout: DefaultTimeout, want: true},
+		{name: "timeout disabled", timeout: 0, want: false},
+		{name: "timeout in test args", timeout: DefaultTimeout, testArgs: []string{"-count=1", "-timeout=30s"}, want: false},
+		{name: "separate timeout value in test args", timeout: DefaultTimeout, testArgs: []string{"-timeout", "30s"}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := New(nil)
+			r.WithTimeout(tc.timeout)
+			r.WithTestArgs(tc.testArgs)
+
+			if got := r.needsTimeout(); got != tc.want {
+				t.Fatalf("needsTimeout() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRunnerTestMutationRunsInModuleDir(t *testing.T) {
+	// workspace mode rejects -mod=mod, which may be inherited from the environment
+	t.Setenv("GOFLAGS", "")
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.work"), "go 1.21\n\nuse ./nested\n")
+	moduleDir := filepath.Join(root, "nested")
+	writeFile(t, filepath.Join(moduleDir, "go.mod"), "module example.com/nested\n\ngo 1.21\n")
+	writeFile(t, filepath.Join(moduleDir, "sample.go"), "package sample\n\nfunc Compare(a, b int) bool {\n\treturn a > b\n}\n")
+	writeFile(t, filepath.Join(moduleDir, "sample_test.go"), `package sample
+
+import "testing"
+
+func TestCompare(t *testing.T) {
+	if !Compare(2, 1) {
+		t.Fatalf("expected true")
+	}
+}
+`)
+
+	sb, err := sandbox.New(root)
+	if err != nil {
+		t.Fatalf("failed to create sandbox: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	samplePath := filepath.Join(moduleDir, "sample.go")
+	line, column := findBinaryPosition(t, samplePath, token.GTR)
+	mutation := model.Mutation{
+		FilePath:  samplePath,
+		Line:      line,
+		Column:    column,
+		Mutator:   binaryOpMutator{name: "less-than", target: token.LSS},
+		Module:    "example.com/nested",
+		ModuleDir: moduleDir,
+	}
+
+	result, err := New(sb).TestMutation(mutation, "./...")
+	if err != nil {
+		t.Fatalf("TestMutation returned error: %v", err)
+	}
+	if !result.Killed || !strings.Contains(result.Output, "example.com/nested") {
+		t.Fatalf("expected mutation to be killed by the nested module's tests, got: %+v", result)
+	}
+}
+
+func TestRunnerTestMutationWithOverlaySandbox(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/overlayfixture\n\ngo 1.21\n")
+	samplePath := filepath.Join(root, "sample.go")
+	sampleSource := "package sample\n\nfunc Compare(a, b int) bool {\n\treturn a > b\n}\n"
+	writeFile(t, samplePath, sampleSource)
+	writeFile(t, filepath.Join(root, "sample_test.go"), `package sample
+
+import "testing"
+
+func TestCompare(t *testing.T) {
+	if !Compare(2, 1) {
+		t.Fatalf("expected true")
+	}
+}
+`)
+
+	sb, err := sandbox.NewOverlay(root)
+	if err != nil {
+		t.Fatalf("failed to create overlay sandbox: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	line, column := findBinaryPosition(t, samplePath, token.GTR)
+	mutation := model.Mutation{
+		FilePath: samplePath,
+		Line:     line,
+		Column:   column,
+		Mutator:  binaryOpMutator{name: "less-than", target: token.LSS},
+	}
+
+	result, err := New(sb).TestMutation(mutation, ".")
+	if err != nil {
+		t.Fatalf("TestMutation returned error: %v", err)
+	}
+	if !result.Killed {
+		t.Fatalf("expected mutation to be killed through the overlay, got result: %+v", result)
+	}
+	assertFileRestored(t, samplePath, []byte(sampleSource))
+	if flags := sb.GoFlags(); len(flags) != 0 {
+		t.Fatalf("expected overlay to be reverted, got flags %q", flags)
+	}
+}
+
+// fakeSandbox runs against the original tree and records Apply/Revert calls.
+type fakeSandbox struct {
+	root     string
+	applied  []string
+	reverted []string
+	original map[string][]byte
+}
+
+func (f *fakeSandbox) Root() string                           { return f.root }
+func (f *fakeSandbox) MirrorPath(original string) string      { return original }
+func (f *fakeSandbox) OriginalPath(sandboxPath string) string { return sandboxPath }
+func (f *fakeSandbox) Cleanup() error                         { return nil }
+
+func (f *fakeSandbox) Apply(path string, data []byte) error {
+	original, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	f.original[path] = original
+	f.applied = append(f.applied, path)
+	return os.WriteFile(path, data, 0o644)
+}
+
+func (f *fakeSandbox) Revert(path string) error {
+	f.reverted = append(f.reverted, path)
+	return os.WriteFile(path, f.original[path], 0o644)
+}
+
+func TestRunnerTestMutationUsesSandboxInterface(t *testing.T) {
+	fx := newRunnerFixture(t)
+	fake := &fakeSandbox{root: filepath.Dir(fx.sandboxPath), original: make(map[string][]byte)}
+	mutation := model.Mutation{
+		FilePath: fx.sandboxPath,
+		Line:     fx.line,
+		Column:   fx.column,
+		Mutator:  binaryOpMutator{name: "less-than", target: token.LSS},
+	}
+
+	result, err := New(fake).TestMutation(mutation, ".")
+	if err != nil {
+		t.Fatalf("TestMutation returned error: %v", err)
+	}
+	if !result.Killed {
+		t.Fatalf("expected mutation to be killed, got result: %+v", result)
+	}
+	if len(fake.applied) != 1 || fake.applied[0] != fx.sandboxPath {
+		t.Fatalf("expected one Apply for %s, got %q", fx.sandboxPath, fake.applied)
+	}
+	if len(fake.reverted) != 1 || fake.reverted[0] != fx.sandboxPath {
+		t.Fatalf("expected one Revert for %s, got %q", fx.sandboxPath, fake.reverted)
+	}
+	assertFileRestored(t, fx.sandboxPath, fx.originalContent)
+}
+
+func TestRunnerTestMutationDeletesStatementKeepingItCompilable(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/stmtfixture\n\ngo 1.21\n")
+	samplePath := filepath.Join(root, "sample.go")
+	writeFile(t, samplePath, `package sample
+
+import "fmt"
+
+var entries []string
+
+func Record(msg string) {
+	prefix := "> "
+	entries = append(entries, fmt.Sprint(prefix, msg))
+}
+`)
+	writeFile(t, filepath.Join(root, "sample_test.go"), `package sample
+
+import "testing"
+
+func TestRecord(t *testing.T) {
+	Record("x")
+	if len(entries) != 1 {
+		t.Fatalf("expected one entry")
+	}
+}
+`)
+
+	sb, err := sandbox.New(root)
+	if err != nil {
+		t.Fatalf("failed to create sandbox: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	ld := loader.New()
+	if err := ld.Load(sb.Root()); err != nil {
+		t.Fatalf("failed to load packages: %v", err)
+	}
+
+	mutation := model.Mutation{
+		FilePath:  samplePath,
+		Line:      9,
+		Column:    10,
+		EndLine:   9,
+		EndColumn: 52,
+		NodeType:  "*ast.AssignStmt",
+		Mutator:   statement.StatementDeletion{},
+	}
+
+	r := New(sb)
+	r.WithLoader(ld)
+	result, err := r.TestMutation(mutation, ".")
+	if err != nil {
+		t.Fatalf("TestMutation returned error: %v", err)
+	}
+	if !result.Killed {
+		t.Fatalf("expected mutation to be killed, got result: %+v", result)
+	}
+	if strings.Contains(result.Output, "not used") {
+		t.Fatalf("expected the mutant to compile, got: %s", result.Output)
+	}
+	if !strings.Contains(result.Output, "expected one entry") {
+		t.Fatalf("expected the test to fail on the missing side effect, got: %s", result.Output)
+	}
+}
+
+func TestRunnerTestMutationAddsImport(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/returnfixture\n\ngo 1.21\n")
+	samplePath := filepath.Join(root, "sample.go")
+	writeFile(t, samplePath, `package sample
+
+func Parse(s string) (int, error) {
+	return len(s), nil
+}
+`)
+	writeFile(t, filepath.Join(root, "sample_test.go"), `package sample
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	if _, err := Parse("x"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+`)
+
+	sb, err := sandbox.New(root)
+	if err != nil {
+		t.Fatalf("failed to create sandbox: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	ld := loader.New()
+	if err := ld.Load(sb.Root()); err != nil {
+		t.Fatalf("failed to load packages: %v", err)
+	}
+
+	mutation := model.Mutation{
+		FilePath:  samplePath,
+		Line:      4,
+		Column:    2,
+		EndLine:   4,
+		EndColumn: 20,
+		NodeType:  "*ast.ReturnStmt",
+		Mutator:   return_value.ReturnError{},
+	}
+
+	r := New(sb)
+	r.WithLoader(ld)
+	result, err := r.TestMutation(mutation, ".")
+	if err != nil {
+		t.Fatalf("TestMutation returned error: %v", err)
+	}
+	if !strings.Contains(result.Output, "unexpected error: axiom") {
+		t.Fatalf("expected the mutant to compile and return an error, got: %s", result.Output)
+	}
+}
+
+func TestRunnerTestMutationTargetNotFound(t *testing.T) {
+	fx := newRunnerFixture(t)
+	mutation := model.Mutation{
+		FilePath:  fx.filePath,
+		Line:      fx.line,
+		Column:    fx.column,
+		EndLine:   fx.line,
+		EndColumn: fx.column + 1,
+		NodeType:  "*ast.IncDecStmt",
+		Mutator:   binaryOpMutator{name: "less-than", target: token.LSS},
+	}
+
+	if _, err := fx.runner.TestMutation(mutation, "."); err == nil {
+		t.Fatal("expected error when the mutation target is not found")
+	}
+	assertFileRestored(t, fx.sandboxPath, fx.originalContent)
+}
+
+func TestRunnerTestMutationRejectsUnchangedReplacement(t *testing.T) {
+	fx := newRunnerFixture(t)
+	mutation := model.Mutation{
+		FilePath: fx.filePath,
+		Line:     fx.line,
+		Column:   fx.column,
+		Mutator:  unchangedMutator{},
+	}
+
+	if _, err := fx.runner.TestMutation(mutation, "."); err == nil {
+		t.Fatal("expected error when the mutator returns the target unchanged")
+	}
+	assertFileRestored(t, fx.sandboxPath, fx.originalContent)
+}
+
+// unchangedMutator returns binary expressions unchanged, like a type-aware rewriter without type information.
+type unchangedMutator struct{}
+
+func (m unchangedMutator) Name() string { return "unchanged" }
+
+func (m unchangedMutator) CanMutate(node ast.Node) bool {
+	_, ok := node.(*ast.BinaryExpr)
+	return ok
+}
+
+func (m unchangedMutator) Mutate(node ast.Node) ast.Node { return node }
+
+func TestRunnerTestMutationSkipsNotCompiled(t *testing.T) {
+	r := New(nil)
+	mutation := model.Mutation{
+		FilePath:    filepath.Join(t.TempDir(), "excluded_windows.go"),
+		NotCompiled: true,
+	}
+
+	result, err := r.TestMutation(mutation, ".")
+	if err != nil {
+		t.Fatalf("TestMutation returned error: %v", err)
+	}
+	if result.Killed {
+		t.Fatalf("expected not compiled mutation to be reported without running tests, got %+v", result)
+	}
+}
+
 func TestRunnerTestMutationMissingFile(t *testing.T) {
 	r := New(nil)
 	missingPath := filepath.Join(t.TempDir(), "does", "not", "exist.go")
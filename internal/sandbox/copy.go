@@ -0,0 +1,172 @@
+package sandbox
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+var _ Sandbox = (*Copy)(nil)
+
+// Copy is a sandbox holding a copy of the source tree in a temporary directory.
+type Copy struct {
+	mirror
+}
+
+// New creates a sandbox by recursively copying the module or workspace enclosing the given root directory into a temporary directory.
+func New(originalRoot string) (*Copy, error) {
+	return newCopy(originalRoot, false)
+}
+
+// NewLinked creates a sandbox like New but hard-links files instead of copying them,
+// which makes creating the sandbox nearly free for large trees. Files are only ever
+// replaced through Apply and Revert, so the original checkout is never modified.
+func NewLinked(originalRoot string) (*Copy, error) {
+	return newCopy(originalRoot, true)
+}
+
+func newCopy(originalRoot string, link bool) (*Copy, error) {
+	originalRoot, err := rootDir(originalRoot)
+	if err != nil {
+		return nil, err
+	}
+	copyRoot := findCopyRoot(originalRoot)
+
+	tempDir, err := os.MkdirTemp("", "axiom-sandbox-*")
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the tree into the sandbox.
+	if err := copyTree(copyRoot, tempDir, link); err != nil {
+		os.RemoveAll(tempDir)
+		return nil, err
+	}
+
+	return &Copy{mirror{originalRoot: originalRoot, moduleRoot: copyRoot, copyRoot: copyRoot, tempDir: tempDir}}, nil
+}
+
+// Cleanup removes the sandbox directory.
+func (s *Copy) Cleanup() error {
+	if s == nil {
+		return nil
+	}
+	return os.RemoveAll(s.tempDir)
+}
+
+// copyTree mirrors src into dst. VCS directories and paths ignored by .gitignore files are
+// skipped; symlinks are recreated and file modes preserved. Regular files are reflinked or
+// copied, or hard-linked when link is set.
+func copyTree(src, dst string, link bool) error {
+	c := copier{src: src, dst: dst, link: link}
+	return c.copyDir(src, ".", nil)
+}
+
+type copier struct {
+	src, dst string
+	link     bool
+}
+
+func (c copier) copyDir(absDir, relDir string, rules ignoreList) error {
+	info, err := os.Stat(absDir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(c.dst, relDir), info.Mode().Perm()|0700); err != nil {
+		return err
+	}
+
+	rules = rules.withFile(absDir, relSlash(relDir))
+	entries, err := os.ReadDir(absDir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		rel := filepath.Join(relDir, name)
+		if vcsDirs[name] || rules.ignored(relSlash(rel), entry.IsDir()) {
+			continue
+		}
+
+		srcPath := filepath.Join(absDir, name)
+		dstPath := filepath.Join(c.dst, rel)
+
+		var err error
+		switch {
+		case entry.Type()&fs.ModeSymlink != 0:
+			err = c.copySymlink(srcPath, dstPath)
+		case entry.IsDir():
+			err = c.copyDir(srcPath, rel, rules)
+		case !entry.Type().IsRegular():
+			// sockets, devices and pipes have no place in a source tree
+		case name == "go.mod" || name == "go.work":
+			err = copyModFile(srcPath, dstPath, c.src)
+		case c.link:
+			err = linkFile(srcPath, dstPath)
+		default:
+			err = copyFile(srcPath, dstPath)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copySymlink recreates a symlink. Relative targets leaving the copied tree are made absolute
+// so they keep pointing at the original location.
+func (c copier) copySymlink(src, dst string) error {
+	target, err := os.Readlink(src)
+	if err != nil {
+		return err
+	}
+	if !filepath.IsAbs(target) {
+		resolved := filepath.Join(filepath.Dir(src), target)
+		if !isAncestor(c.src, resolved) {
+			target = resolved
+		}
+	}
+	return os.Symlink(target, dst)
+}
+
+// linkFile hard-links src to dst, falling back to a copy across devices.
+// Linked files must only be replaced, never written in place; see mirror.Apply and replaceFile.
+func linkFile(src, dst string) error {
+	if err := os.Link(src, dst); err == nil {
+		return nil
+	}
+	return copyFile(src, dst)
+}
+
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if cloneFile(in, out) == nil {
+		return out.Chmod(info.Mode().Perm())
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		return err
+	}
+	// the umask may have narrowed the mode passed to OpenFile
+	return out.Chmod(info.Mode().Perm())
+}
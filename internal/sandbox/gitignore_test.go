@@ -0,0 +1,71 @@
+package sandbox
+
+import "testing"
+
+func TestIgnoreListMatching(t *testing.T) {
+	var rules ignoreList
+	for _, line := range []string{
+		"# comment",
+		"",
+		"*.log",
+		"!keep.log",
+		"build/",
+		"/root-only.txt",
+		"docs/**/*.pdf",
+		"node_modules",
+	} {
+		if rule, ok := parseIgnoreLine(line, "."); ok {
+			rules = append(rules, rule)
+		}
+	}
+	if rule, ok := parseIgnoreLine("*.tmp", "sub"); ok {
+		rules = append(rules, rule)
+	}
+
+	tests := []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{path: "debug.log", want: true},
+		{path: "nested/dir/debug.log", want: true},
+		{path: "keep.log", want: false},
+		{path: "build", isDir: true, want: true},
+		{path: "build", isDir: false, want: false},
+		{path: "pkg/build", isDir: true, want: true},
+		{path: "root-only.txt", want: true},
+		{path: "pkg/root-only.txt", want: false},
+		{path: "docs/manual.pdf", want: true},
+		{path: "docs/a/b/manual.pdf", want: true},
+		{path: "other/manual.pdf", want: false},
+		{path: "web/node_modules", isDir: true, want: true},
+		{path: "sub/x.tmp", want: true},
+		{path: "x.tmp", want: false},
+		{path: "main.go", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := rules.ignored(tt.path, tt.isDir); got != tt.want {
+			t.Fatalf("ignored(%q, dir=%v) = %v, want %v", tt.path, tt.isDir, got, tt.want)
+		}
+	}
+}
+
+func TestGlobToRegexp(t *testing.T) {
+	tests := []struct {
+		glob string
+		want string
+	}{
+		{glob: "*.go", want: `[^/]*\.go`},
+		{glob: "a?c", want: `a[^/]c`},
+		{glob: "[!a]b", want: `[^a]b`},
+		{glob: "**/x", want: `(?:.*/)?x`},
+		{glob: "x/**", want: `x/.*`},
+	}
+
+	for _, tt := range tests {
+		if got := globToRegexp(tt.glob); got != tt.want {
+			t.Fatalf("globToRegexp(%q) = %q, want %q", tt.glob, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package sandbox
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/renja-g/axiom/internal/workspace"
+)
+
+// findCopyRoot returns the directory that has to be mirrored for tests in dir to behave
+// like in the original checkout: the workspace root when a go.work above dir uses the
+// enclosing module, otherwise the module root, otherwise dir itself.
+func findCopyRoot(dir string) string {
+	modRoot := findUp(dir, "go.mod")
+	if modRoot == "" {
+		return dir
+	}
+
+	workRoot := findUp(dir, "go.work")
+	if workRoot == "" || !isAncestor(workRoot, modRoot) {
+		return modRoot
+	}
+	data, err := os.ReadFile(filepath.Join(workRoot, "go.work"))
+	if err != nil {
+		return modRoot
+	}
+	for _, use := range workspace.Directives(data, "use") {
+		if filepath.Clean(filepath.Join(workRoot, use.Args[0])) == modRoot {
+			return workRoot
+		}
+	}
+	return modRoot
+}
+
+// findUp returns the nearest directory at or above dir containing the named file.
+func findUp(dir, name string) string {
+	for {
+		if info, err := os.Stat(filepath.Join(dir, name)); err == nil && !info.IsDir() {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// copyModFile copies a go.mod or go.work file, rewriting relative replace and use
+// directives that point outside the copied tree to the absolute original location.
+func copyModFile(src, dst, copyRoot string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	data = rewriteLocalPaths(data, filepath.Dir(src), copyRoot)
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(dst, data, info.Mode().Perm())
+}
+
+// rewriteLocalPaths resolves relative filesystem paths in replace and use directives
+// against modDir and makes them absolute when they leave copyRoot.
+func rewriteLocalPaths(data []byte, modDir, copyRoot string) []byte {
+	lines := strings.Split(string(data), "\n")
+	changed := false
+
+	rewrite := func(line int, target string) {
+		if !isLocalPath(target) || filepath.IsAbs(target) {
+			return
+		}
+		abs := filepath.Clean(filepath.Join(modDir, target))
+		if isAncestor(copyRoot, abs) {
+			return
+		}
+		idx := strings.LastIndex(lines[line], target)
+		if idx < 0 {
+			return
+		}
+		replacement := abs
+		quoted := idx > 0 && lines[line][idx-1] == '"'
+		if !quoted && strings.ContainsAny(abs, " \t\"'`") {
+			replacement = strconv.Quote(abs)
+		}
+		lines[line] = lines[line][:idx] + replacement + lines[line][idx+len(target):]
+		changed = true
+	}
+
+	for _, d := range workspace.Directives(data, "replace") {
+		for i, arg := range d.Args {
+			if arg == "=>" && i+1 < len(d.Args) {
+				rewrite(d.Line, d.Args[i+1])
+			}
+		}
+	}
+	for _, d := range workspace.Directives(data, "use") {
+		rewrite(d.Line, d.Args[0])
+	}
+
+	if !changed {
+		return data
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// isLocalPath reports whether a replace target is a filesystem path rather than a module path,
+// following the go command's rule: it must be absolute or start with ./ or ../.
+func isLocalPath(p string) bool {
+	return filepath.IsAbs(p) || p == "." || p == ".." ||
+		strings.HasPrefix(p, "./") || strings.HasPrefix(p, "../") ||
+		strings.HasPrefix(p, "."+string(filepath.Separator)) || strings.HasPrefix(p, ".."+string(filepath.Separator))
+}
+
+// isAncestor reports whether path is root or lies below it.
+func isAncestor(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,118 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+)
+
+var _ Sandbox = (*Overlay)(nil)
+
+// Overlay is a sandbox that never copies the source tree. Mutated files are written to a
+// temporary directory and substituted for the originals through `go test -overlay`, so tests
+// run against the original checkout with only the mutated file replaced.
+type Overlay struct {
+	root    string
+	tempDir string
+
+	mu      sync.Mutex
+	replace map[string]string
+	next    int
+}
+
+// NewOverlay creates an overlay sandbox for the given root directory.
+func NewOverlay(originalRoot string) (*Overlay, error) {
+	originalRoot, err := rootDir(originalRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	tempDir, err := os.MkdirTemp("", "axiom-overlay-*")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Overlay{root: originalRoot, tempDir: tempDir, replace: make(map[string]string)}, nil
+}
+
+// Root returns the original root; an overlay sandbox has no copy of the tree.
+func (s *Overlay) Root() string {
+	return s.root
+}
+
+// MirrorPath returns the original path unchanged.
+func (s *Overlay) MirrorPath(original string) string {
+	return original
+}
+
+// OriginalPath returns the sandbox path unchanged.
+func (s *Overlay) OriginalPath(sandboxPath string) string {
+	return sandboxPath
+}
+
+// Apply writes data to a temporary file that replaces path in the overlay.
+func (s *Overlay) Apply(path string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target, ok := s.replace[path]
+	if !ok {
+		s.next++
+		target = filepath.Join(s.tempDir, strconv.Itoa(s.next)+"_"+filepath.Base(path))
+	}
+	if err := os.WriteFile(target, data, 0644); err != nil {
+		return err
+	}
+	s.replace[path] = target
+	return s.writeOverlay()
+}
+
+// Revert removes path from the overlay so the original file is used again.
+func (s *Overlay) Revert(path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	target, ok := s.replace[path]
+	if !ok {
+		return nil
+	}
+	delete(s.replace, path)
+	if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return s.writeOverlay()
+}
+
+// GoFlags returns the -overlay flag while any file is replaced.
+func (s *Overlay) GoFlags() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.replace) == 0 {
+		return nil
+	}
+	return []string{"-overlay=" + s.overlayPath()}
+}
+
+// Cleanup removes the overlay directory.
+func (s *Overlay) Cleanup() error {
+	if s == nil {
+		return nil
+	}
+	return os.RemoveAll(s.tempDir)
+}
+
+func (s *Overlay) overlayPath() string {
+	return filepath.Join(s.tempDir, "overlay.json")
+}
+
+// writeOverlay writes the overlay file in the format expected by the go command's -overlay flag.
+func (s *Overlay) writeOverlay() error {
+	data, err := json.Marshal(struct{ Replace map[string]string }{s.replace})
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.overlayPath(), data, 0644)
+}
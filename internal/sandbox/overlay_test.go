@@ -0,0 +1,76 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestOverlayApplyAndRevert(t *testing.T) {
+	root := t.TempDir()
+	original := filepath.Join(root, "file.go")
+	writeFile(t, original, "package original\n")
+
+	sb, err := NewOverlay(root)
+	if err != nil {
+		t.Fatalf("NewOverlay returned error: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	if sb.Root() != root || sb.MirrorPath(original) != original || sb.OriginalPath(original) != original {
+		t.Fatalf("overlay sandbox should not translate paths")
+	}
+	if flags := sb.GoFlags(); len(flags) != 0 {
+		t.Fatalf("expected no go flags before Apply, got %q", flags)
+	}
+
+	if err := sb.Apply(original, []byte("package mutated\n")); err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	if data := readFile(t, original); string(data) != "package original\n" {
+		t.Fatalf("original file must not be modified: %q", string(data))
+	}
+
+	flags := sb.GoFlags()
+	if len(flags) != 1 || !strings.HasPrefix(flags[0], "-overlay=") {
+		t.Fatalf("GoFlags = %q, want a single -overlay flag", flags)
+	}
+	var overlay struct{ Replace map[string]string }
+	if err := json.Unmarshal(readFile(t, strings.TrimPrefix(flags[0], "-overlay=")), &overlay); err != nil {
+		t.Fatalf("invalid overlay file: %v", err)
+	}
+	replacement, ok := overlay.Replace[original]
+	if !ok {
+		t.Fatalf("overlay does not replace %s: %+v", original, overlay.Replace)
+	}
+	if data := readFile(t, replacement); string(data) != "package mutated\n" {
+		t.Fatalf("unexpected replacement contents: %q", string(data))
+	}
+
+	if err := sb.Revert(original); err != nil {
+		t.Fatalf("Revert returned error: %v", err)
+	}
+	if flags := sb.GoFlags(); len(flags) != 0 {
+		t.Fatalf("expected no go flags after Revert, got %q", flags)
+	}
+	if _, err := os.Stat(replacement); !os.IsNotExist(err) {
+		t.Fatalf("expected replacement file to be removed, stat err = %v", err)
+	}
+}
+
+func TestOverlayCleanup(t *testing.T) {
+	sb, err := NewOverlay(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewOverlay returned error: %v", err)
+	}
+	tempDir := sb.tempDir
+
+	if err := sb.Cleanup(); err != nil {
+		t.Fatalf("Cleanup returned error: %v", err)
+	}
+	if _, err := os.Stat(tempDir); !os.IsNotExist(err) {
+		t.Fatalf("expected overlay directory to be removed, stat err = %v", err)
+	}
+}
@@ -0,0 +1,18 @@
+//go:build !windows
+
+package sandbox
+
+import (
+	"os"
+	"syscall"
+)
+
+// processAlive reports whether a process with the given pid is running.
+func processAlive(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	err = p.Signal(syscall.Signal(0))
+	return err == nil || err == syscall.EPERM
+}
@@ -0,0 +1,14 @@
+package sandbox
+
+import "os"
+
+// processAlive reports whether a process with the given pid is running.
+// On Windows FindProcess fails for processes that no longer exist.
+func processAlive(pid int) bool {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	p.Release()
+	return true
+}
@@ -0,0 +1,13 @@
+//go:build !linux
+
+package sandbox
+
+import (
+	"errors"
+	"os"
+)
+
+// cloneFile is only implemented on Linux; callers fall back to a byte copy.
+func cloneFile(src, dst *os.File) error {
+	return errors.ErrUnsupported
+}
@@ -1,112 +1,149 @@
 package sandbox
 
 import (
-	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sync"
 )
 
-// Sandbox copies a source tree into a temporary directory where mutations can run safely.
-// It keeps track of both the original root and the mirrored sandbox root to translate paths.
-type Sandbox struct {
-	originalRoot string
-	root         string
+// Sandbox isolates mutated files from the original checkout. Implementations translate
+// between original and sandbox paths and apply mutated file contents where `go test` will see them.
+type Sandbox interface {
+	// Root returns the sandbox path corresponding to the original root.
+	Root() string
+	// MirrorPath converts an original file path into the corresponding sandbox path.
+	MirrorPath(original string) string
+	// OriginalPath converts a sandbox file path back to the original path.
+	OriginalPath(sandboxPath string) string
+	// Apply replaces the contents of the sandbox file at path with data.
+	Apply(path string, data []byte) error
+	// Revert restores a file changed by Apply to its original contents.
+	Revert(path string) error
+	// Cleanup removes everything the sandbox created.
+	Cleanup() error
 }
 
-// New creates a sandbox by recursively copying the given root directory into a temporary directory.
-func New(originalRoot string) (*Sandbox, error) {
-	info, err := os.Stat(originalRoot)
-	if err != nil {
-		return nil, err
-	}
-	if !info.IsDir() {
-		return nil, &fs.PathError{Op: "sandbox", Path: originalRoot, Err: fs.ErrInvalid}
-	}
+// GoFlagger is an optional interface for sandboxes that need extra flags on every
+// go command run against them, such as -overlay.
+type GoFlagger interface {
+	GoFlags() []string
+}
 
-	tempDir, err := os.MkdirTemp("", "axiom-sandbox-*")
-	if err != nil {
-		return nil, err
-	}
+// mirror implements path translation and copy-on-write file replacement for sandboxes
+// that mirror a directory tree into a temporary directory.
+//
+// The mirrored tree is the enclosing module (or workspace) root rather than just the requested
+// directory, so go.mod, go.work and embedded files above it remain available. Relative replace
+// and use directives pointing outside the mirrored tree are rewritten to absolute paths.
+type mirror struct {
+	originalRoot string
+	moduleRoot   string
+	copyRoot     string
+	tempDir      string
 
-	// Copy the tree into the sandbox.
-	if err := copyTree(originalRoot, tempDir); err != nil {
-		os.RemoveAll(tempDir)
-		return nil, err
-	}
+	mu        sync.Mutex
+	originals map[string][]byte
+}
 
-	return &Sandbox{originalRoot: originalRoot, root: tempDir}, nil
+// Root returns the filesystem path to the sandbox copy of the original root.
+func (s *mirror) Root() string {
+	return s.MirrorPath(s.originalRoot)
 }
 
-// Root returns the filesystem path to the sandbox copy.
-func (s *Sandbox) Root() string {
-	return s.root
+// ModuleRoot returns the sandbox path of the module or workspace root enclosing the original root.
+func (s *mirror) ModuleRoot() string {
+	return s.MirrorPath(s.moduleRoot)
 }
 
 // MirrorPath converts an original file path into the corresponding sandbox path.
-func (s *Sandbox) MirrorPath(original string) string {
-	rel, err := filepath.Rel(s.originalRoot, original)
+func (s *mirror) MirrorPath(original string) string {
+	rel, err := filepath.Rel(s.copyRoot, original)
 	if err != nil {
 		return original
 	}
-	return filepath.Join(s.root, rel)
+	return filepath.Join(s.tempDir, rel)
 }
 
 // OriginalPath converts a sandbox file path back to the original path.
-func (s *Sandbox) OriginalPath(sandboxPath string) string {
-	rel, err := filepath.Rel(s.root, sandboxPath)
+func (s *mirror) OriginalPath(sandboxPath string) string {
+	rel, err := filepath.Rel(s.tempDir, sandboxPath)
 	if err != nil {
 		return sandboxPath
 	}
-	return filepath.Join(s.originalRoot, rel)
+	return filepath.Join(s.copyRoot, rel)
 }
 
-// Cleanup removes the sandbox directory.
-func (s *Sandbox) Cleanup() error {
-	if s == nil {
-		return nil
-	}
-	return os.RemoveAll(s.root)
-}
+// Apply replaces the sandbox file at path with data, remembering its original contents for Revert.
+func (s *mirror) Apply(path string, data []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-func copyTree(src, dst string) error {
-	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+	if _, ok := s.originals[path]; !ok {
+		original, err := os.ReadFile(path)
 		if err != nil {
 			return err
 		}
-
-		rel, err := filepath.Rel(src, path)
-		if err != nil {
-			return err
+		if s.originals == nil {
+			s.originals = make(map[string][]byte)
 		}
-		target := filepath.Join(dst, rel)
+		s.originals[path] = original
+	}
+	return replaceFile(path, data)
+}
 
-		if d.IsDir() {
-			return os.MkdirAll(target, 0755)
-		}
+// Revert restores a file changed by Apply. Reverting an unchanged file is a no-op.
+func (s *mirror) Revert(path string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-		return copyFile(path, target)
-	})
+	original, ok := s.originals[path]
+	if !ok {
+		return nil
+	}
+	if err := replaceFile(path, original); err != nil {
+		return err
+	}
+	delete(s.originals, path)
+	return nil
 }
 
-func copyFile(src, dst string) error {
-	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
-		return err
+// replaceFile replaces the file at path with data, keeping its mode. The data is written to a
+// temporary file that is renamed over path, so a hard link to the original checkout is broken
+// instead of written through: only mutated files are ever copied.
+func replaceFile(path string, data []byte) error {
+	perm := fs.FileMode(0644)
+	if info, err := os.Stat(path); err == nil {
+		perm = info.Mode().Perm()
 	}
-	in, err := os.Open(src)
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".axiom-*.tmp")
 	if err != nil {
 		return err
 	}
-	defer in.Close()
+	defer os.Remove(tmp.Name())
 
-	out, err := os.Create(dst)
-	if err != nil {
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
 		return err
 	}
-	defer out.Close()
-
-	if _, err := io.Copy(out, in); err != nil {
+	if err := tmp.Close(); err != nil {
 		return err
 	}
-	return nil
+	if err := os.Chmod(tmp.Name(), perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// rootDir validates that root is a directory and returns its absolute path.
+func rootDir(root string) (string, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return "", err
+	}
+	if !info.IsDir() {
+		return "", &fs.PathError{Op: "sandbox", Path: root, Err: fs.ErrInvalid}
+	}
+	return filepath.Abs(root)
 }
@@ -93,12 +93,208 @@ func TestCleanupRemovesSandbox(t *testing.T) {
 }
 
 func TestCleanupNilSandbox(t *testing.T) {
-	var sb *Sandbox
+	var sb *Copy
 	if err := sb.Cleanup(); err != nil {
 		t.Fatalf("Cleanup on nil sandbox returned error: %v", err)
 	}
 }
 
+func TestNewCopiesEnclosingModule(t *testing.T) {
+	moduleRoot := t.TempDir()
+	writeFile(t, filepath.Join(moduleRoot, "go.mod"), "module example.com/m\n")
+	writeFile(t, filepath.Join(moduleRoot, "assets", "logo.txt"), "logo")
+	sub := filepath.Join(moduleRoot, "pkg", "sub")
+	writeFile(t, filepath.Join(sub, "sub.go"), "package sub\n")
+
+	sb, err := New(sub)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	if want := filepath.Join(sb.ModuleRoot(), "pkg", "sub"); sb.Root() != want {
+		t.Fatalf("Root() = %q, want %q", sb.Root(), want)
+	}
+	if data := readFile(t, filepath.Join(sb.ModuleRoot(), "go.mod")); string(data) != "module example.com/m\n" {
+		t.Fatalf("unexpected go.mod contents: %q", string(data))
+	}
+	if data := readFile(t, filepath.Join(sb.ModuleRoot(), "assets", "logo.txt")); string(data) != "logo" {
+		t.Fatalf("unexpected asset contents: %q", string(data))
+	}
+
+	original := filepath.Join(sub, "sub.go")
+	if got := sb.OriginalPath(sb.MirrorPath(original)); got != original {
+		t.Fatalf("OriginalPath(MirrorPath(%q)) = %q", original, got)
+	}
+}
+
+func TestNewRewritesRelativeReplaceDirectives(t *testing.T) {
+	repo := t.TempDir()
+	moduleRoot := filepath.Join(repo, "service")
+	writeFile(t, filepath.Join(repo, "shared", "go.mod"), "module example.com/shared\n")
+	writeFile(t, filepath.Join(moduleRoot, "go.mod"), `module example.com/service
+
+replace example.com/shared => ../shared
+
+replace (
+	example.com/local => ./local
+	example.com/remote => example.com/fork v1.0.0
+)
+`)
+
+	sb, err := New(moduleRoot)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	want := `module example.com/service
+
+replace example.com/shared => ` + filepath.Join(repo, "shared") + `
+
+replace (
+	example.com/local => ./local
+	example.com/remote => example.com/fork v1.0.0
+)
+`
+	if data := readFile(t, filepath.Join(sb.Root(), "go.mod")); string(data) != want {
+		t.Fatalf("go.mod not rewritten as expected:\n%s\nwant:\n%s", string(data), want)
+	}
+	if data := readFile(t, filepath.Join(moduleRoot, "go.mod")); string(data) == want {
+		t.Fatalf("original go.mod must not be modified")
+	}
+}
+
+func TestNewCopiesWorkspaceUsingModule(t *testing.T) {
+	workRoot := t.TempDir()
+	writeFile(t, filepath.Join(workRoot, "go.work"), "go 1.21\n\nuse (\n\t./a\n\t./b\n)\n")
+	writeFile(t, filepath.Join(workRoot, "a", "go.mod"), "module example.com/a\n")
+	writeFile(t, filepath.Join(workRoot, "b", "go.mod"), "module example.com/b\n")
+
+	sb, err := New(filepath.Join(workRoot, "a"))
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	if _, err := os.Stat(filepath.Join(sb.ModuleRoot(), "go.work")); err != nil {
+		t.Fatalf("expected go.work to be copied: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(sb.ModuleRoot(), "b", "go.mod")); err != nil {
+		t.Fatalf("expected sibling workspace module to be copied: %v", err)
+	}
+	if want := filepath.Join(sb.ModuleRoot(), "a"); sb.Root() != want {
+		t.Fatalf("Root() = %q, want %q", sb.Root(), want)
+	}
+}
+
+func TestNewSkipsVCSAndIgnoredFiles(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, ".gitignore"), "*.bin\nnode_modules/\n")
+	writeFile(t, filepath.Join(root, ".git", "HEAD"), "ref: refs/heads/main")
+	writeFile(t, filepath.Join(root, "main.go"), "package main\n")
+	writeFile(t, filepath.Join(root, "fixture.bin"), "large")
+	writeFile(t, filepath.Join(root, "web", "node_modules", "x.js"), "x")
+	writeFile(t, filepath.Join(root, "sub", ".gitignore"), "generated.txt\n")
+	writeFile(t, filepath.Join(root, "sub", "generated.txt"), "gen")
+	writeFile(t, filepath.Join(root, "sub", "kept.txt"), "kept")
+
+	sb, err := New(root)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	for _, rel := range []string{".git", "fixture.bin", filepath.Join("web", "node_modules"), filepath.Join("sub", "generated.txt")} {
+		if _, err := os.Lstat(filepath.Join(sb.Root(), rel)); !os.IsNotExist(err) {
+			t.Fatalf("expected %s to be skipped, stat err = %v", rel, err)
+		}
+	}
+	for _, rel := range []string{"main.go", ".gitignore", filepath.Join("sub", "kept.txt")} {
+		if _, err := os.Lstat(filepath.Join(sb.Root(), rel)); err != nil {
+			t.Fatalf("expected %s to be copied: %v", rel, err)
+		}
+	}
+}
+
+func TestNewPreservesModesAndSymlinks(t *testing.T) {
+	root := t.TempDir()
+	script := filepath.Join(root, "run.sh")
+	writeFile(t, script, "#!/bin/sh\n")
+	if err := os.Chmod(script, 0o755); err != nil {
+		t.Fatalf("chmod failed: %v", err)
+	}
+	writeFile(t, filepath.Join(root, "data", "real.txt"), "real")
+	if err := os.Symlink(filepath.Join("data", "real.txt"), filepath.Join(root, "link.txt")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+
+	sb, err := New(root)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	info, err := os.Stat(filepath.Join(sb.Root(), "run.sh"))
+	if err != nil {
+		t.Fatalf("stat failed: %v", err)
+	}
+	if info.Mode().Perm() != 0o755 {
+		t.Fatalf("mode = %v, want 0755", info.Mode().Perm())
+	}
+
+	target, err := os.Readlink(filepath.Join(sb.Root(), "link.txt"))
+	if err != nil {
+		t.Fatalf("expected link.txt to be a symlink: %v", err)
+	}
+	if target != filepath.Join("data", "real.txt") {
+		t.Fatalf("symlink target = %q, want %q", target, filepath.Join("data", "real.txt"))
+	}
+}
+
+func TestNewLinkedCopiesOnWrite(t *testing.T) {
+	root := t.TempDir()
+	original := filepath.Join(root, "file.go")
+	writeFile(t, original, "package original\n")
+
+	sb, err := NewLinked(root)
+	if err != nil {
+		t.Fatalf("NewLinked returned error: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	mirror := sb.MirrorPath(original)
+	origInfo, err := os.Stat(original)
+	if err != nil {
+		t.Fatalf("stat failed: %v", err)
+	}
+	mirrorInfo, err := os.Stat(mirror)
+	if err != nil {
+		t.Fatalf("stat failed: %v", err)
+	}
+	if !os.SameFile(origInfo, mirrorInfo) {
+		t.Fatalf("expected sandbox file to be a hard link of the original")
+	}
+
+	if err := sb.Apply(mirror, []byte("package mutated\n")); err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+
+	if data := readFile(t, original); string(data) != "package original\n" {
+		t.Fatalf("original file modified through hard link: %q", string(data))
+	}
+	if data := readFile(t, mirror); string(data) != "package mutated\n" {
+		t.Fatalf("sandbox file not updated: %q", string(data))
+	}
+
+	if err := sb.Revert(mirror); err != nil {
+		t.Fatalf("Revert returned error: %v", err)
+	}
+	if data := readFile(t, mirror); string(data) != "package original\n" {
+		t.Fatalf("sandbox file not reverted: %q", string(data))
+	}
+}
+
 func writeFile(t *testing.T, path, contents string) {
 	t.Helper()
 	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
@@ -0,0 +1,191 @@
+package sandbox
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// worktreeLockPrefix marks the lock reason of worktrees created by axiom, followed by the owning pid.
+const worktreeLockPrefix = "axiom pid "
+
+var _ Sandbox = (*Worktree)(nil)
+
+// Worktree is a sandbox backed by a detached `git worktree` of the original repository.
+type Worktree struct {
+	mirror
+	repoRoot string
+}
+
+// NewWorktree creates a sandbox as a detached `git worktree` of the repository containing
+// originalRoot, checked out at the current commit. Uncommitted changes to tracked files are
+// applied as a patch and untracked, non-ignored files are copied, so the sandbox matches the
+// working tree without copying the whole repository.
+//
+// The worktree is locked with the creating process id. Worktrees left behind by a crashed run
+// are removed the next time a worktree sandbox is created in the same repository.
+func NewWorktree(originalRoot string) (*Worktree, error) {
+	originalRoot, err := rootDir(originalRoot)
+	if err != nil {
+		return nil, err
+	}
+	// git reports the resolved top level; resolve symlinks so relative paths line up
+	if resolved, err := filepath.EvalSymlinks(originalRoot); err == nil {
+		originalRoot = resolved
+	}
+
+	out, err := git(originalRoot, nil, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, err
+	}
+	repoRoot := filepath.Clean(strings.TrimSpace(string(out)))
+
+	reapStaleWorktrees(repoRoot)
+
+	tempDir, err := os.MkdirTemp("", "axiom-worktree-*")
+	if err != nil {
+		return nil, err
+	}
+
+	lockReason := worktreeLockPrefix + strconv.Itoa(os.Getpid())
+	if _, err := git(repoRoot, nil, "worktree", "add", "--detach", "--lock", "--reason", lockReason, tempDir, "HEAD"); err != nil {
+		os.RemoveAll(tempDir)
+		return nil, err
+	}
+
+	sb := &Worktree{
+		mirror: mirror{
+			originalRoot: originalRoot,
+			moduleRoot:   findCopyRoot(originalRoot),
+			copyRoot:     repoRoot,
+			tempDir:      tempDir,
+		},
+		repoRoot: repoRoot,
+	}
+	if err := sb.syncWorkingTree(); err != nil {
+		sb.Cleanup()
+		return nil, err
+	}
+	return sb, nil
+}
+
+// syncWorkingTree brings uncommitted and untracked changes of the original checkout into the worktree
+// and resolves relative replace directives of the enclosing module that leave the repository.
+func (s *Worktree) syncWorkingTree() error {
+	patch, err := git(s.repoRoot, nil, "diff", "--binary", "--no-color", "--no-ext-diff", "HEAD")
+	if err != nil {
+		return err
+	}
+	if len(patch) > 0 {
+		if _, err := git(s.tempDir, patch, "apply", "--binary", "--whitespace=nowarn", "-"); err != nil {
+			return err
+		}
+	}
+
+	untracked, err := git(s.repoRoot, nil, "ls-files", "--others", "--exclude-standard", "-z")
+	if err != nil {
+		return err
+	}
+	for _, rel := range strings.Split(string(untracked), "\x00") {
+		if rel == "" {
+			continue
+		}
+		src := filepath.Join(s.repoRoot, filepath.FromSlash(rel))
+		info, err := os.Lstat(src)
+		if err != nil {
+			return err
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			c := copier{src: s.repoRoot, dst: s.tempDir}
+			err = c.copySymlink(src, s.MirrorPath(src))
+		} else {
+			err = copyFile(src, s.MirrorPath(src))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, name := range []string{"go.mod", "go.work"} {
+		src := filepath.Join(s.moduleRoot, name)
+		if _, err := os.Stat(src); err != nil {
+			continue
+		}
+		if err := copyModFile(src, s.MirrorPath(src), s.repoRoot); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Cleanup unlocks and removes the worktree.
+func (s *Worktree) Cleanup() error {
+	if s == nil {
+		return nil
+	}
+	return removeWorktree(s.repoRoot, s.tempDir)
+}
+
+// removeWorktree unregisters and deletes a worktree created by NewWorktree.
+func removeWorktree(repoRoot, dir string) error {
+	_, _ = git(repoRoot, nil, "worktree", "unlock", dir)
+	_, removeErr := git(repoRoot, nil, "worktree", "remove", "--force", dir)
+	// remove leftovers (e.g. ignored build output) and any registration git could not clean up
+	if err := os.RemoveAll(dir); err != nil && removeErr == nil {
+		removeErr = err
+	}
+	_, _ = git(repoRoot, nil, "worktree", "prune")
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+	return removeErr
+}
+
+// reapStaleWorktrees removes worktrees locked by axiom processes that are no longer running.
+func reapStaleWorktrees(repoRoot string) {
+	out, err := git(repoRoot, nil, "worktree", "list", "--porcelain")
+	if err != nil {
+		return
+	}
+	for _, entry := range strings.Split(string(out), "\n\n") {
+		var path, reason string
+		for _, line := range strings.Split(entry, "\n") {
+			switch {
+			case strings.HasPrefix(line, "worktree "):
+				path = strings.TrimPrefix(line, "worktree ")
+			case strings.HasPrefix(line, "locked "):
+				reason = strings.TrimPrefix(line, "locked ")
+			}
+		}
+		if path == "" || !strings.HasPrefix(reason, worktreeLockPrefix) {
+			continue
+		}
+		pid, err := strconv.Atoi(strings.TrimPrefix(reason, worktreeLockPrefix))
+		if err != nil || processAlive(pid) {
+			continue
+		}
+		_ = removeWorktree(repoRoot, path)
+	}
+	_, _ = git(repoRoot, nil, "worktree", "prune")
+}
+
+// git runs a git command in dir, feeding stdin if given, and returns its standard output.
+func git(dir string, stdin []byte, args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
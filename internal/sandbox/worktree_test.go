@@ -0,0 +1,133 @@
+package sandbox
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewWorktreeMirrorsWorkingTree(t *testing.T) {
+	repo := newGitRepo(t)
+	writeFile(t, filepath.Join(repo, "go.mod"), "module example.com/wt\n")
+	writeFile(t, filepath.Join(repo, "pkg", "a.go"), "package pkg\n")
+	writeFile(t, filepath.Join(repo, ".gitignore"), "*.log\n")
+	runGit(t, repo, "add", ".")
+	runGit(t, repo, "commit", "-qm", "initial")
+
+	// uncommitted, untracked and ignored changes
+	writeFile(t, filepath.Join(repo, "pkg", "a.go"), "package pkg\n\nconst Dirty = true\n")
+	writeFile(t, filepath.Join(repo, "pkg", "new.go"), "package pkg\n")
+	writeFile(t, filepath.Join(repo, "debug.log"), "ignored")
+
+	sb, err := NewWorktree(filepath.Join(repo, "pkg"))
+	if err != nil {
+		t.Fatalf("NewWorktree returned error: %v", err)
+	}
+
+	if want := filepath.Join(sb.ModuleRoot(), "pkg"); sb.Root() != want {
+		t.Fatalf("Root() = %q, want %q", sb.Root(), want)
+	}
+	if data := readFile(t, filepath.Join(sb.Root(), "a.go")); !strings.Contains(string(data), "Dirty") {
+		t.Fatalf("uncommitted change not applied: %q", string(data))
+	}
+	if _, err := os.Stat(filepath.Join(sb.Root(), "new.go")); err != nil {
+		t.Fatalf("untracked file not copied: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(sb.ModuleRoot(), "debug.log")); !os.IsNotExist(err) {
+		t.Fatalf("ignored file should not be copied, stat err = %v", err)
+	}
+
+	worktreeDir := sb.ModuleRoot()
+	if err := sb.Cleanup(); err != nil {
+		t.Fatalf("Cleanup returned error: %v", err)
+	}
+	if _, err := os.Stat(worktreeDir); !os.IsNotExist(err) {
+		t.Fatalf("expected worktree directory to be removed, stat err = %v", err)
+	}
+	if out := runGit(t, repo, "worktree", "list"); strings.Count(out, "\n") != 1 {
+		t.Fatalf("expected only the main worktree to remain, got:\n%s", out)
+	}
+}
+
+func TestNewWorktreeIgnoresDiffConfiguration(t *testing.T) {
+	repo := newGitRepo(t)
+	runGit(t, repo, "config", "color.ui", "always")
+	runGit(t, repo, "config", "diff.external", "false")
+	writeFile(t, filepath.Join(repo, "go.mod"), "module example.com/wt\n")
+	writeFile(t, filepath.Join(repo, "a.go"), "package wt\n")
+	runGit(t, repo, "add", ".")
+	runGit(t, repo, "commit", "-qm", "initial")
+	writeFile(t, filepath.Join(repo, "a.go"), "package wt\n\nconst Dirty = true\n")
+
+	sb, err := NewWorktree(repo)
+	if err != nil {
+		t.Fatalf("NewWorktree returned error: %v", err)
+	}
+	defer sb.Cleanup()
+
+	if data := readFile(t, filepath.Join(sb.Root(), "a.go")); !strings.Contains(string(data), "Dirty") {
+		t.Fatalf("uncommitted change not applied: %q", string(data))
+	}
+}
+
+func TestNewWorktreeReapsStaleWorktrees(t *testing.T) {
+	repo := newGitRepo(t)
+	writeFile(t, filepath.Join(repo, "a.txt"), "a")
+	runGit(t, repo, "add", ".")
+	runGit(t, repo, "commit", "-qm", "initial")
+
+	// simulate a worktree left behind by a crashed run
+	stale := filepath.Join(t.TempDir(), "axiom-worktree-stale")
+	runGit(t, repo, "worktree", "add", "--detach", "--lock", "--reason", worktreeLockPrefix+"999999999", stale, "HEAD")
+
+	sb, err := NewWorktree(repo)
+	if err != nil {
+		t.Fatalf("NewWorktree returned error: %v", err)
+	}
+	t.Cleanup(func() { sb.Cleanup() })
+
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Fatalf("expected stale worktree to be removed, stat err = %v", err)
+	}
+	if out := runGit(t, repo, "worktree", "list"); strings.Contains(out, stale) {
+		t.Fatalf("stale worktree still registered:\n%s", out)
+	}
+}
+
+func TestNewWorktreeOutsideRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	t.Setenv("GIT_CEILING_DIRECTORIES", os.TempDir())
+	if _, err := NewWorktree(t.TempDir()); err == nil {
+		t.Fatal("expected error outside a git repository")
+	}
+}
+
+func newGitRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	repo := t.TempDir()
+	if resolved, err := filepath.EvalSymlinks(repo); err == nil {
+		repo = resolved
+	}
+	runGit(t, repo, "init", "-q")
+	runGit(t, repo, "config", "user.email", "axiom@example.com")
+	runGit(t, repo, "config", "user.name", "axiom")
+	return repo
+}
+
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return string(out)
+}
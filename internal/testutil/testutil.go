@@ -0,0 +1,79 @@
+// Package testutil type-checks sample sources for the tests of type-aware mutators.
+package testutil
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+)
+
+// Check parses src, keeping comments, and type-checks it as package sample. It fails the test
+// when src doesn't compile.
+func Check(t testing.TB, fset *token.FileSet, src string) (*ast.File, *types.Info) {
+	t.Helper()
+	file, err := parser.ParseFile(fset, "sample.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("failed to parse: %v", err)
+	}
+	info := &types.Info{
+		Types:        make(map[ast.Expr]types.TypeAndValue),
+		Defs:         make(map[*ast.Ident]types.Object),
+		Uses:         make(map[*ast.Ident]types.Object),
+		Implicits:    make(map[ast.Node]types.Object),
+		Selections:   make(map[*ast.SelectorExpr]*types.Selection),
+		Scopes:       make(map[ast.Node]*types.Scope),
+		FileVersions: make(map[*ast.File]string),
+	}
+	conf := types.Config{Importer: importer.Default()}
+	if _, err := conf.Check("sample", fset, []*ast.File{file}, info); err != nil {
+		t.Fatalf("failed to type-check: %v", err)
+	}
+	return file, info
+}
+
+// Nodes type-checks src and returns its nodes of type T in source order.
+func Nodes[T ast.Node](t testing.TB, src string) ([]T, *types.Info) {
+	t.Helper()
+	file, info := Check(t, token.NewFileSet(), src)
+
+	var nodes []T
+	ast.Inspect(file, func(n ast.Node) bool {
+		if node, ok := n.(T); ok {
+			nodes = append(nodes, node)
+		}
+		return true
+	})
+	return nodes, info
+}
+
+// Find type-checks src and returns the outermost node of type T starting at the first
+// occurrence of text.
+func Find[T ast.Node](t testing.TB, src, text string) (T, *types.Info) {
+	t.Helper()
+	file, info := Check(t, token.NewFileSet(), src)
+
+	offset := strings.Index(src, text)
+	if offset < 0 {
+		t.Fatalf("%q not found in source", text)
+	}
+	pos := file.FileStart + token.Pos(offset)
+	var found T
+	var ok bool
+	ast.Inspect(file, func(n ast.Node) bool {
+		if ok || n == nil {
+			return false
+		}
+		if node, isT := n.(T); isT && n.Pos() == pos {
+			found, ok = node, true
+		}
+		return true
+	})
+	if !ok {
+		t.Fatalf("no %T at %q", found, text)
+	}
+	return found, info
+}
@@ -0,0 +1,48 @@
+package testutil
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+)
+
+const source = `package sample
+
+func f(n int) int {
+	return n + n*2
+}
+`
+
+func TestNodes(t *testing.T) {
+	exprs, info := Nodes[*ast.BinaryExpr](t, source)
+
+	if len(exprs) != 2 {
+		t.Fatalf("Nodes() found %d binary expressions, want 2", len(exprs))
+	}
+	if got := types.ExprString(exprs[0]); got != "n + n * 2" {
+		t.Fatalf("Nodes()[0] = %s, want n + n * 2", got)
+	}
+	if info.TypeOf(exprs[1]) == nil {
+		t.Fatalf("Nodes() returned no type for %s", types.ExprString(exprs[1]))
+	}
+}
+
+func TestFind(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "n + n", want: "n + n * 2"},
+		{text: "n*2", want: "n * 2"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.text, func(t *testing.T) {
+			expr, _ := Find[ast.Expr](t, source, tc.text)
+
+			if got := types.ExprString(expr); got != tc.want {
+				t.Fatalf("Find(%q) = %s, want %s", tc.text, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,700 @@
+// Package typeutil builds expressions from type information for type-aware mutators.
+package typeutil
+
+import (
+	"go/ast"
+	"go/build"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"math"
+
+	"github.com/renja-g/axiom/internal/astutil"
+)
+
+// File returns the file containing pos among the files type-checked into info.
+// It relies on info.FileVersions, which records every checked file.
+func File(info *types.Info, pos token.Pos) *ast.File {
+	for file := range info.FileVersions {
+		if file.FileStart <= pos && pos <= file.FileEnd {
+			return file
+		}
+	}
+	return nil
+}
+
+// Scope returns the innermost scope containing pos, or nil when pos lies outside the checked files.
+func Scope(info *types.Info, pos token.Pos) *types.Scope {
+	file := File(info, pos)
+	if file == nil {
+		return nil
+	}
+	fileScope, ok := info.Scopes[file]
+	if !ok {
+		return nil
+	}
+	return fileScope.Innermost(pos)
+}
+
+// Package returns the package the file containing pos belongs to.
+func Package(info *types.Info, pos token.Pos) *types.Package {
+	file := File(info, pos)
+	if file == nil {
+		return nil
+	}
+	fileScope, ok := info.Scopes[file]
+	if !ok {
+		return nil
+	}
+	pkgScope := fileScope.Parent()
+	for _, name := range pkgScope.Names() {
+		if pkg := pkgScope.Lookup(name).Pkg(); pkg != nil {
+			return pkg
+		}
+	}
+	return nil
+}
+
+// EnclosingSignature returns the signature of the innermost function declaration or literal containing pos.
+func EnclosingSignature(info *types.Info, pos token.Pos) *types.Signature {
+	file := File(info, pos)
+	if file == nil {
+		return nil
+	}
+
+	var sig *types.Signature
+	ast.Inspect(file, func(n ast.Node) bool {
+		if n == nil || pos < n.Pos() || pos >= n.End() {
+			return false
+		}
+		switch fn := n.(type) {
+		case *ast.FuncDecl:
+			if obj, ok := info.Defs[fn.Name].(*types.Func); ok {
+				sig, _ = obj.Type().(*types.Signature)
+			}
+		case *ast.FuncLit:
+			sig, _ = info.TypeOf(fn).(*types.Signature)
+		}
+		return true
+	})
+	return sig
+}
+
+// PackageName returns the name under which the package with the given import path can be
+// referenced at pos. When the file doesn't import it, the package's default name is returned
+// together with needsImport, provided the name isn't taken by another declaration in scope.
+// ok is false when the package can't be referenced at pos.
+func PackageName(info *types.Info, pos token.Pos, path, defaultName string) (name string, needsImport, ok bool) {
+	scope := Scope(info, pos)
+	if scope == nil {
+		return "", false, false
+	}
+	file := File(info, pos)
+	for _, spec := range file.Imports {
+		pkgName := importedAs(info, spec)
+		if pkgName == nil || pkgName.Imported().Path() != path {
+			continue
+		}
+		if _, obj := scope.LookupParent(pkgName.Name(), pos); obj == pkgName {
+			return pkgName.Name(), false, true
+		}
+	}
+	if _, obj := scope.LookupParent(defaultName, pos); obj != nil {
+		return "", false, false
+	}
+	return defaultName, true, true
+}
+
+// importedAs returns the package name declared by an import spec.
+func importedAs(info *types.Info, spec *ast.ImportSpec) *types.PkgName {
+	var obj types.Object
+	if spec.Name != nil {
+		obj = info.Defs[spec.Name]
+	} else {
+		obj = info.Implicits[spec]
+	}
+	pkgName, _ := obj.(*types.PkgName)
+	return pkgName
+}
+
+// Qualifier returns a types.Qualifier naming packages as they can be referenced at pos.
+// Packages that can't be referenced without a new import are reported through missing.
+func Qualifier(info *types.Info, pos token.Pos, missing *bool) types.Qualifier {
+	current := Package(info, pos)
+	return func(pkg *types.Package) string {
+		if pkg == current {
+			return ""
+		}
+		name, needsImport, ok := PackageName(info, pos, pkg.Path(), pkg.Name())
+		if !ok || needsImport {
+			*missing = true
+		}
+		return name
+	}
+}
+
+// ZeroValue returns an expression for the zero value of t as written at pos, such as 0, "",
+// false, nil, T{} or *new(T) for type parameters. It returns nil when t can't be spelled at pos,
+// e.g. a struct type from a package the file doesn't import.
+func ZeroValue(info *types.Info, pos token.Pos, t types.Type) ast.Expr {
+	if _, ok := t.(*types.TypeParam); ok {
+		typeExpr := typeExpr(info, pos, t)
+		if typeExpr == nil {
+			return nil
+		}
+		return &ast.StarExpr{X: &ast.CallExpr{Fun: ast.NewIdent("new"), Args: []ast.Expr{typeExpr}}}
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return ast.NewIdent("false")
+		case u.Info()&types.IsString != 0:
+			return &ast.BasicLit{Kind: token.STRING, Value: `""`}
+		case u.Info()&types.IsNumeric != 0:
+			return &ast.BasicLit{Kind: token.INT, Value: "0"}
+		case u.Kind() == types.UnsafePointer || u.Kind() == types.UntypedNil:
+			return ast.NewIdent("nil")
+		}
+	case *types.Pointer, *types.Slice, *types.Map, *types.Chan, *types.Signature, *types.Interface:
+		return ast.NewIdent("nil")
+	case *types.Struct, *types.Array:
+		typeExpr := typeExpr(info, pos, t)
+		if typeExpr == nil {
+			return nil
+		}
+		return &ast.CompositeLit{Type: typeExpr}
+	}
+	return nil
+}
+
+// TypedZeroValue returns the zero value of t like ZeroValue, converted to t where the untyped
+// zero would take another type from its context, e.g. int64(0) or (*T)(nil). It can replace an
+// expression whose type isn't fixed by its context, as in x := f().
+func TypedZeroValue(info *types.Info, pos token.Pos, t types.Type) ast.Expr {
+	zero := ZeroValue(info, pos, t)
+	if zero == nil {
+		return nil
+	}
+	var untyped types.Type
+	switch zero := zero.(type) {
+	case *ast.BasicLit:
+		untyped = types.Typ[types.Int]
+		if zero.Kind == token.STRING {
+			untyped = types.Typ[types.String]
+		}
+	case *ast.Ident:
+		if zero.Name == "false" {
+			untyped = types.Typ[types.Bool]
+		}
+	default: // composite literals and *new(T) are typed
+		return zero
+	}
+	if untyped != nil && types.Identical(t, untyped) {
+		return zero
+	}
+	typ := typeExpr(info, pos, t)
+	switch typ.(type) {
+	case nil:
+		return nil
+	case *ast.StarExpr, *ast.FuncType, *ast.ChanType:
+		typ = &ast.ParenExpr{X: typ}
+	}
+	return &ast.CallExpr{Fun: typ, Args: []ast.Expr{zero}}
+}
+
+// typeExpr returns an expression spelling t at pos, or nil when it can't be spelled there.
+func typeExpr(info *types.Info, pos token.Pos, t types.Type) ast.Expr {
+	missing := false
+	s := types.TypeString(t, Qualifier(info, pos, &missing))
+	if missing {
+		return nil
+	}
+	expr, err := parser.ParseExpr(s)
+	if err != nil {
+		return nil
+	}
+	return expr
+}
+
+// IsZero reports whether expr is already a zero value: nil, a zero constant or an empty composite literal.
+func IsZero(info *types.Info, expr ast.Expr) bool {
+	tv, ok := info.Types[expr]
+	if !ok {
+		return false
+	}
+	if tv.IsNil() {
+		return true
+	}
+	if tv.Value != nil {
+		return tv.Value.ExactString() == "0" || tv.Value.ExactString() == `""` || tv.Value.ExactString() == "false"
+	}
+	lit, ok := ast.Unparen(expr).(*ast.CompositeLit)
+	return ok && len(lit.Elts) == 0
+}
+
+// IsError reports whether t is the predeclared error interface.
+func IsError(t types.Type) bool {
+	return t != nil && types.Identical(t, types.Universe.Lookup("error").Type())
+}
+
+// Unused returns expressions referring to the local variables and imported packages that are
+// only used within the removed nodes, and so would be reported as unused once they are removed.
+// Packages are referred to through a member, as in fmt.Println, preferring one that BlankAssign
+// can keep in use; see Keepable. Objects declared within the removed nodes are skipped since they
+// disappear with them.
+func Unused(info *types.Info, removed ...ast.Node) []ast.Expr {
+	keepable, unkeepable := unused(info, removed)
+	return append(keepable, unkeepable...)
+}
+
+// Keepable reports whether BlankAssign can keep everything Unused returns for the removed nodes
+// in use. It can't when a package is only used through types or generic functions, as in
+// statement.StatementDeletion{}, which aren't values.
+func Keepable(info *types.Info, removed ...ast.Node) bool {
+	_, unkeepable := unused(info, removed)
+	return len(unkeepable) == 0
+}
+
+// unused implements Unused, returning the expressions BlankAssign can keep in use
+// separately from the package members it can't.
+func unused(info *types.Info, removed []ast.Node) (keepable, unkeepable []ast.Expr) {
+	if info == nil {
+		return nil, nil
+	}
+	within := func(pos token.Pos) bool {
+		for _, n := range removed {
+			if n.Pos() <= pos && pos < n.End() {
+				return true
+			}
+		}
+		return false
+	}
+	var assigned map[*ast.Ident]bool
+	onlyUsedWithin := func(obj types.Object) bool {
+		for ident, used := range info.Uses {
+			if used != obj || within(ident.Pos()) {
+				continue
+			}
+			if assigned == nil {
+				assigned = assignedIdents(info)
+			}
+			// assigning to a variable doesn't use it
+			if !assigned[ident] {
+				return false
+			}
+		}
+		return true
+	}
+
+	seen := make(map[types.Object]bool)
+	// packages only used through members that aren't values so far, in order of appearance
+	var unreferable []*types.PkgName
+	members := make(map[*types.PkgName]*ast.SelectorExpr)
+	for _, n := range removed {
+		ast.Inspect(n, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.SelectorExpr:
+				// pkg.Member: keep the package used through a referable member
+				ident, ok := n.X.(*ast.Ident)
+				if !ok {
+					return true
+				}
+				pkgName, ok := info.Uses[ident].(*types.PkgName)
+				if !ok {
+					return true
+				}
+				if seen[pkgName] {
+					return false
+				}
+				member := &ast.SelectorExpr{X: ast.NewIdent(ident.Name), Sel: ast.NewIdent(n.Sel.Name)}
+				switch {
+				case !onlyUsedWithin(pkgName):
+					seen[pkgName] = true
+				case referable(info.Uses[n.Sel]):
+					seen[pkgName] = true
+					keepable = append(keepable, member)
+				case members[pkgName] == nil:
+					unreferable = append(unreferable, pkgName)
+					members[pkgName] = member
+				}
+				return false
+			case *ast.Ident:
+				v, ok := info.Uses[n].(*types.Var)
+				// variables declared within the removed nodes, including type switch clause variables, go with them
+				if !ok || seen[v] || !isLocal(info, v) || within(v.Pos()) || within(v.Parent().Pos()) {
+					return true
+				}
+				seen[v] = true
+				if onlyUsedWithin(v) {
+					keepable = append(keepable, ast.NewIdent(n.Name))
+				}
+			}
+			return true
+		})
+	}
+	for _, pkgName := range unreferable {
+		if !seen[pkgName] {
+			unkeepable = append(unkeepable, members[pkgName])
+		}
+	}
+	return keepable, unkeepable
+}
+
+// assignedIdents returns the identifiers assigned to with = or redeclared with := in the checked
+// files. Unlike other uses they don't count as using the variable.
+func assignedIdents(info *types.Info) map[*ast.Ident]bool {
+	assigned := make(map[*ast.Ident]bool)
+	for file := range info.FileVersions {
+		ast.Inspect(file, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok || (assign.Tok != token.ASSIGN && assign.Tok != token.DEFINE) {
+				return true
+			}
+			for _, lhs := range assign.Lhs {
+				if ident, ok := ast.Unparen(lhs).(*ast.Ident); ok {
+					assigned[ident] = true
+				}
+			}
+			return true
+		})
+	}
+	return assigned
+}
+
+// isLocal reports whether v is a local variable, which unlike parameters, results, fields
+// and package variables is reported as unused.
+func isLocal(info *types.Info, v *types.Var) bool {
+	if v.IsField() || v.Pkg() == nil || v.Parent() == nil || v.Parent() == v.Pkg().Scope() {
+		return false
+	}
+	if sig := EnclosingSignature(info, v.Pos()); sig != nil {
+		if sig.Recv() == v {
+			return false
+		}
+		for _, tuple := range []*types.Tuple{sig.Params(), sig.Results()} {
+			for i := 0; i < tuple.Len(); i++ {
+				if tuple.At(i) == v {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// referable reports whether a package member can appear as a value in `_ = pkg.Member`.
+func referable(obj types.Object) bool {
+	switch obj := obj.(type) {
+	case *types.Var, *types.Const:
+		return true
+	case *types.Func:
+		sig, ok := obj.Type().(*types.Signature)
+		return ok && sig.TypeParams().Len() == 0
+	}
+	return false
+}
+
+// BlankAssign returns `_, _ = x, y` keeping the given expressions in use, or nil when there are none.
+func BlankAssign(exprs []ast.Expr) ast.Stmt {
+	if len(exprs) == 0 {
+		return nil
+	}
+	lhs := make([]ast.Expr, len(exprs))
+	for i := range lhs {
+		lhs[i] = ast.NewIdent("_")
+	}
+	return &ast.AssignStmt{Lhs: lhs, Tok: token.ASSIGN, Rhs: exprs}
+}
+
+// KeepUsed returns stmt preceded by a blank assignment of everything only used within the removed
+// nodes, wrapped in a block, or stmt itself when nothing would become unused. Packages stmt still
+// refers to, e.g. through a zero value pkg.T{}, stay in use by themselves. It returns nil when a
+// package can't be kept in use; see Keepable.
+func KeepUsed(info *types.Info, stmt ast.Stmt, removed ...ast.Node) ast.Stmt {
+	keepable, unkeepable := unused(info, removed)
+	if len(unreferenced(stmt, unkeepable)) > 0 {
+		return nil
+	}
+	assign := BlankAssign(unreferenced(stmt, keepable))
+	if assign == nil {
+		return stmt
+	}
+	return &ast.BlockStmt{List: []ast.Stmt{assign, stmt}}
+}
+
+// unreferenced returns the expressions among exprs other than package members whose package stmt refers to.
+func unreferenced(stmt ast.Stmt, exprs []ast.Expr) []ast.Expr {
+	var kept []ast.Expr
+	for _, expr := range exprs {
+		if sel, ok := expr.(*ast.SelectorExpr); ok && astutil.UsesPackage(stmt, sel.X.(*ast.Ident).Name) {
+			continue
+		}
+		kept = append(kept, expr)
+	}
+	return kept
+}
+
+// Sizes returns the sizes of the gc compiler for the target architecture, which the GOARCH
+// environment variable selects as it does for the go command.
+func Sizes() types.Sizes {
+	return types.SizesFor("gc", build.Default.GOARCH)
+}
+
+// Representable reports whether the constant value fits the basic type t without overflowing,
+// as it must when a mutated literal takes the place of the original. Integer sizes are those of
+// the gc compiler on the target architecture. Untyped and non-numeric types always report true.
+func Representable(value constant.Value, t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsUntyped != 0 {
+		return true
+	}
+	switch {
+	case basic.Info()&types.IsInteger != 0:
+		v := constant.ToInt(value)
+		if v.Kind() != constant.Int {
+			return false
+		}
+		bits := uint(8 * Sizes().Sizeof(basic))
+		if basic.Info()&types.IsUnsigned != 0 {
+			return constant.Sign(v) >= 0 && constant.BitLen(v) <= int(bits)
+		}
+		limit := constant.Shift(constant.MakeInt64(1), token.SHL, bits-1)
+		return constant.Compare(v, token.LSS, limit) && !constant.Compare(v, token.LSS, constant.UnaryOp(token.SUB, limit, 0))
+	case basic.Kind() == types.Float32:
+		f, _ := constant.Float32Val(constant.ToFloat(value))
+		return !math.IsInf(float64(f), 0)
+	case basic.Kind() == types.Float64:
+		f, _ := constant.Float64Val(constant.ToFloat(value))
+		return !math.IsInf(f, 0)
+	}
+	return true
+}
+
+// FoldConstant evaluates the constant expressions enclosing expr with expr replaced by value,
+// and returns the outermost one with its new value. ok is false when a value along the way
+// doesn't fit its type or can't be evaluated, e.g. a division by zero.
+func FoldConstant(info *types.Info, file *ast.File, expr ast.Expr, value constant.Value) (ast.Expr, constant.Value, bool) {
+	for {
+		if t := info.TypeOf(expr); t != nil {
+			value = convert(value, t)
+			if !Representable(value, t) {
+				return nil, nil, false
+			}
+		}
+		parent, ok := astutil.Parent(file, expr).(ast.Expr)
+		if !ok || info.Types[parent].Value == nil {
+			return expr, value, true
+		}
+
+		switch p := parent.(type) {
+		case *ast.ParenExpr:
+		case *ast.UnaryExpr:
+			value = constant.UnaryOp(p.Op, value, precision(info.TypeOf(p)))
+		case *ast.BinaryExpr:
+			x, y := info.Types[p.X].Value, info.Types[p.Y].Value
+			if p.X == expr {
+				x = value
+			} else {
+				y = value
+			}
+			if value, ok = evalBinary(p.Op, x, y); !ok {
+				return nil, nil, false
+			}
+		case *ast.CallExpr:
+			// only numeric conversions keep the value, they are checked as the next expression
+			if !info.Types[p.Fun].IsType() || !isNumeric(value) || !isNumericType(info.TypeOf(p)) {
+				return nil, nil, false
+			}
+		default:
+			return nil, nil, false
+		}
+		expr = parent
+	}
+}
+
+// evalBinary evaluates the constant expression x op y.
+func evalBinary(op token.Token, x, y constant.Value) (constant.Value, bool) {
+	switch op {
+	case token.SHL, token.SHR:
+		x = constant.ToInt(x)
+		n, ok := constant.Uint64Val(constant.ToInt(y))
+		if !ok || x.Kind() != constant.Int {
+			return nil, false
+		}
+		return constant.Shift(x, op, uint(n)), true
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return constant.MakeBool(constant.Compare(x, op, y)), true
+	case token.QUO, token.REM:
+		if constant.Sign(y) == 0 {
+			return nil, false
+		}
+		if op == token.QUO && x.Kind() == constant.Int && y.Kind() == constant.Int {
+			op = token.QUO_ASSIGN // integer division
+		}
+	}
+	return constant.BinaryOp(x, op, y), true
+}
+
+// precision returns the size in bits of an unsigned integer type t, which bounds the result of
+// a bitwise complement, and 0 for any other type.
+func precision(t types.Type) uint {
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsUnsigned == 0 || basic.Info()&types.IsUntyped != 0 {
+		return 0
+	}
+	return uint(8 * Sizes().Sizeof(basic))
+}
+
+// convert returns the numeric value as the kind of constant of type t, e.g. 1 as a float for
+// float64, so that operations on it, like division, follow t.
+func convert(value constant.Value, t types.Type) constant.Value {
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok || !isNumeric(value) {
+		return value
+	}
+	switch {
+	case basic.Info()&types.IsInteger != 0:
+		return constant.ToInt(value)
+	case basic.Info()&types.IsFloat != 0:
+		return constant.ToFloat(value)
+	case basic.Info()&types.IsComplex != 0:
+		return constant.ToComplex(value)
+	}
+	return value
+}
+
+// isNumericType reports whether t is a numeric type.
+func isNumericType(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsNumeric != 0
+}
+
+// isNumeric reports whether v is a numeric constant.
+func isNumeric(v constant.Value) bool {
+	switch v.Kind() {
+	case constant.Int, constant.Float, constant.Complex:
+		return true
+	}
+	return false
+}
+
+// IsFormatString reports whether expr is the format argument of a printf-style call: the string
+// parameter right before a final ...any parameter, as in fmt.Printf or t.Fatalf.
+func IsFormatString(info *types.Info, parent ast.Node, expr ast.Expr) bool {
+	call, ok := parent.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sig, ok := info.TypeOf(call.Fun).(*types.Signature)
+	if !ok || !sig.Variadic() || sig.Params().Len() < 2 {
+		return false
+	}
+	index := sig.Params().Len() - 2
+	if index >= len(call.Args) || call.Args[index] != expr {
+		return false
+	}
+	variadic, ok := sig.Params().At(index + 1).Type().(*types.Slice)
+	if !ok {
+		return false
+	}
+	iface, ok := variadic.Elem().Underlying().(*types.Interface)
+	return ok && iface.Empty()
+}
+
+// DuplicatesConstant reports whether replacing expr with the constant value would duplicate another
+// key of the same map literal or another case of the same switch statement, which doesn't compile.
+func DuplicatesConstant(info *types.Info, expr ast.Expr, value constant.Value) bool {
+	file := File(info, expr.Pos())
+	if file == nil {
+		return false
+	}
+	var siblings []ast.Expr
+	switch p := astutil.Parent(file, expr).(type) {
+	case *ast.KeyValueExpr:
+		if p.Key != expr {
+			return false
+		}
+		composite, ok := astutil.Parent(file, p).(*ast.CompositeLit)
+		if !ok {
+			return false
+		}
+		for _, elt := range composite.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				siblings = append(siblings, kv.Key)
+			}
+		}
+	case *ast.CaseClause:
+		body, ok := astutil.Parent(file, p).(*ast.BlockStmt)
+		if !ok {
+			return false
+		}
+		for _, stmt := range body.List {
+			if clause, ok := stmt.(*ast.CaseClause); ok {
+				siblings = append(siblings, clause.List...)
+			}
+		}
+	default:
+		return false
+	}
+
+	for _, sibling := range siblings {
+		tv := info.Types[sibling]
+		if sibling != expr && tv.Value != nil && equalConstants(tv.Value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ConstantContext reports whether replacing expr with a constant may not compile because the
+// enclosing expression would be constant-folded: as a divisor or shift count, next to another
+// constant operand other than in a comparison, next to a constant slice index, or when indexing or slicing a constant string.
+func ConstantContext(info *types.Info, expr ast.Expr) bool {
+	file := File(info, expr.Pos())
+	if file == nil {
+		return true
+	}
+	switch p := astutil.Parent(file, expr).(type) {
+	case *ast.BinaryExpr:
+		switch p.Op {
+		case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+			// comparing constants always compiles
+			return false
+		}
+		if p.Y == expr {
+			switch p.Op {
+			case token.QUO, token.REM, token.SHL, token.SHR:
+				return true
+			}
+			return info.Types[p.X].Value != nil
+		}
+		return info.Types[p.Y].Value != nil
+	case *ast.IndexExpr:
+		return p.X == expr
+	case *ast.SliceExpr:
+		if p.X == expr {
+			return true
+		}
+		for _, index := range []ast.Expr{p.Low, p.High, p.Max} {
+			if index != nil && index != expr && info.Types[index].Value != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// equalConstants reports whether two constants are equal, treating constants of different
+// kinds, such as a string and a number, as unequal.
+func equalConstants(x, y constant.Value) bool {
+	numeric := func(v constant.Value) bool {
+		return v.Kind() == constant.Int || v.Kind() == constant.Float
+	}
+	if x.Kind() != y.Kind() && !(numeric(x) && numeric(y)) {
+		return false
+	}
+	return constant.Compare(x, token.EQL, y)
+}
@@ -0,0 +1,236 @@
+package typeutil
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+import (
+	"strings"
+	str "strconv"
+)
+
+func f() {
+	_ = strings.ToUpper
+	strings := 1
+	_ = strings
+	_ = str.Itoa
+}
+`
+
+func TestPackageName(t *testing.T) {
+	file, info := testutil.Check(t, token.NewFileSet(), source)
+	body := file.Decls[1].(*ast.FuncDecl).Body
+	inside := body.Rbrace
+
+	tests := []struct {
+		name        string
+		pos         token.Pos
+		path        string
+		wantName    string
+		needsImport bool
+		ok          bool
+	}{
+		{name: "renamed import", pos: inside, path: "strconv", wantName: "str", ok: true},
+		{name: "import shadowed by a local", pos: inside, path: "strings", ok: false},
+		{name: "import before shadowing", pos: body.Lbrace, path: "strings", wantName: "strings", ok: true},
+		{name: "missing import", pos: inside, path: "errors", wantName: "errors", needsImport: true, ok: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			name, needsImport, ok := PackageName(info, tc.pos, tc.path, tc.path)
+			if ok != tc.ok || (ok && (name != tc.wantName || needsImport != tc.needsImport)) {
+				t.Fatalf("PackageName() = %q, %v, %v, want %q, %v, %v", name, needsImport, ok, tc.wantName, tc.needsImport, tc.ok)
+			}
+		})
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	file, info := testutil.Check(t, token.NewFileSet(), source)
+	pos := file.Decls[1].(*ast.FuncDecl).Body.Rbrace
+
+	tests := []struct {
+		name string
+		typ  types.Type
+		want string
+	}{
+		{name: "int", typ: types.Typ[types.Int], want: "0"},
+		{name: "string", typ: types.Typ[types.String], want: `""`},
+		{name: "bool", typ: types.Typ[types.Bool], want: "false"},
+		{name: "slice", typ: types.NewSlice(types.Typ[types.Int]), want: "nil"},
+		{name: "array", typ: types.NewArray(types.Typ[types.Int], 2), want: "[2]int{}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			expr := ZeroValue(info, pos, tc.typ)
+			if expr == nil {
+				t.Fatalf("ZeroValue(%s) = nil", tc.typ)
+			}
+			if got := types.ExprString(expr); got != tc.want {
+				t.Fatalf("ZeroValue(%s) = %s, want %s", tc.typ, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTypedZeroValue(t *testing.T) {
+	file, info := testutil.Check(t, token.NewFileSet(), source)
+	pos := file.Decls[1].(*ast.FuncDecl).Body.Rbrace
+
+	tests := []struct {
+		name string
+		typ  types.Type
+		want string
+	}{
+		{name: "int", typ: types.Typ[types.Int], want: "0"},
+		{name: "int64", typ: types.Typ[types.Int64], want: "int64(0)"},
+		{name: "float64", typ: types.Typ[types.Float64], want: "float64(0)"},
+		{name: "string", typ: types.Typ[types.String], want: `""`},
+		{name: "bool", typ: types.Typ[types.Bool], want: "false"},
+		{name: "slice", typ: types.NewSlice(types.Typ[types.Int]), want: "[]int(nil)"},
+		{name: "pointer", typ: types.NewPointer(types.Typ[types.Int]), want: "(*int)(nil)"},
+		{name: "array", typ: types.NewArray(types.Typ[types.Int], 2), want: "[2]int{}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			expr := TypedZeroValue(info, pos, tc.typ)
+			if expr == nil {
+				t.Fatalf("TypedZeroValue(%s) = nil", tc.typ)
+			}
+			if got := types.ExprString(expr); got != tc.want {
+				t.Fatalf("TypedZeroValue(%s) = %s, want %s", tc.typ, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRepresentable(t *testing.T) {
+	tests := []struct {
+		name  string
+		value constant.Value
+		typ   types.Type
+		want  bool
+	}{
+		{name: "int8 max", value: constant.MakeInt64(127), typ: types.Typ[types.Int8], want: true},
+		{name: "int8 overflow", value: constant.MakeInt64(128), typ: types.Typ[types.Int8], want: false},
+		{name: "int8 min", value: constant.MakeInt64(-128), typ: types.Typ[types.Int8], want: true},
+		{name: "negative uint", value: constant.MakeInt64(-1), typ: types.Typ[types.Uint], want: false},
+		{name: "fraction as int", value: constant.MakeFloat64(1.5), typ: types.Typ[types.Int], want: false},
+		{name: "integral float as int", value: constant.MakeFloat64(1001), typ: types.Typ[types.Int], want: true},
+		{name: "float32 overflow", value: constant.MakeFromLiteral("1e39", token.FLOAT, 0), typ: types.Typ[types.Float32], want: false},
+		{name: "float64", value: constant.MakeFromLiteral("1e39", token.FLOAT, 0), typ: types.Typ[types.Float64], want: true},
+		{name: "untyped", value: constant.MakeInt64(-1), typ: types.Typ[types.UntypedInt], want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Representable(tc.value, tc.typ); got != tc.want {
+				t.Fatalf("Representable(%s, %s) = %v, want %v", tc.value, tc.typ, got, tc.want)
+			}
+		})
+	}
+}
+
+const unusedSource = `package sample
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+)
+
+func f() error { return nil }
+
+func g() {
+	var x error
+	x = f()
+	if x != nil {
+	}
+	y := f()
+	if y != nil {
+	}
+	_ = y
+	var z error
+	z = f()
+	if errors.Is(z, nil) {
+	}
+	if y == nil {
+		var b strings.Builder
+		_ = b
+	}
+	if y == nil {
+		var b bytes.Buffer
+		_ = bytes.ToUpper(b.Bytes())
+	}
+}
+`
+
+func TestUnused(t *testing.T) {
+	file, info := testutil.Check(t, token.NewFileSet(), unusedSource)
+	body := file.Decls[len(file.Decls)-1].(*ast.FuncDecl).Body.List
+
+	tests := []struct {
+		name     string
+		removed  ast.Node
+		want     []string
+		keepable bool
+	}{
+		{name: "variable only assigned elsewhere", removed: body[2], want: []string{"x"}, keepable: true},
+		{name: "variable read elsewhere", removed: body[4], want: nil, keepable: true},
+		{name: "package only used by the removed node", removed: body[8], want: []string{"errors.Is", "z"}, keepable: true},
+		{name: "package only used through a type", removed: body[9], want: []string{"strings.Builder"}, keepable: false},
+		{name: "package also used through a function", removed: body[10], want: []string{"bytes.ToUpper"}, keepable: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, expr := range Unused(info, tc.removed) {
+				got = append(got, types.ExprString(expr))
+			}
+			if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+				t.Fatalf("Unused() = %v, want %v", got, tc.want)
+			}
+			if got := Keepable(info, tc.removed); got != tc.keepable {
+				t.Fatalf("Keepable() = %v, want %v", got, tc.keepable)
+			}
+		})
+	}
+}
+
+func TestKeepUsed(t *testing.T) {
+	file, info := testutil.Check(t, token.NewFileSet(), unusedSource)
+	body := file.Decls[len(file.Decls)-1].(*ast.FuncDecl).Body.List
+	stmt := &ast.ExprStmt{X: ast.NewIdent("f")}
+
+	if got := KeepUsed(info, stmt, body[4]); got != stmt {
+		t.Fatalf("KeepUsed() = %#v, want the statement itself", got)
+	}
+	block, ok := KeepUsed(info, stmt, body[8]).(*ast.BlockStmt)
+	if !ok || len(block.List) != 2 || block.List[1] != stmt {
+		t.Fatalf("KeepUsed() = %#v, want a block keeping errors and z in use", block)
+	}
+	if got := KeepUsed(info, stmt, body[9]); got != nil {
+		t.Fatalf("KeepUsed() = %#v, want nil for a package only used through a type", got)
+	}
+	// the mutated statement refers to the package itself
+	zero := &ast.DeclStmt{Decl: &ast.GenDecl{Tok: token.VAR, Specs: []ast.Spec{&ast.ValueSpec{
+		Names: []*ast.Ident{ast.NewIdent("_")},
+		Type:  &ast.SelectorExpr{X: ast.NewIdent("strings"), Sel: ast.NewIdent("Builder")},
+	}}}}
+	if got := KeepUsed(info, zero, body[9]); got != zero {
+		t.Fatalf("KeepUsed() = %#v, want the statement itself", got)
+	}
+}
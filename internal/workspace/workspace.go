@@ -0,0 +1,152 @@
+package workspace
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Module is a Go module found at or below the mutation root.
+type Module struct {
+	// Dir is the directory containing the module's go.mod file.
+	Dir string
+	// Path is the module path declared in go.mod.
+	Path string
+}
+
+// Find returns the modules rooted at or below root.
+// When root contains a go.work file, the modules listed in its use directives are returned;
+// otherwise every go.mod below root (skipping vendor, testdata and hidden directories) is.
+// An empty result means root is not a module itself and lies inside an enclosing module.
+func Find(root string) ([]Module, error) {
+	var dirs []string
+
+	if data, err := os.ReadFile(filepath.Join(root, "go.work")); err == nil {
+		for _, use := range Directives(data, "use") {
+			dir := filepath.Clean(filepath.Join(root, use.Args[0]))
+			if within(root, dir) {
+				dirs = append(dirs, dir)
+			}
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	} else {
+		walkErr := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				base := filepath.Base(path)
+				if path != root && (base == "vendor" || base == "testdata" || base[0] == '.' || base[0] == '_') {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if info.Name() == "go.mod" {
+				dirs = append(dirs, filepath.Dir(path))
+			}
+			return nil
+		})
+		if walkErr != nil {
+			return nil, walkErr
+		}
+	}
+
+	var modules []Module
+	for _, dir := range dirs {
+		modPath, err := ModulePath(filepath.Join(dir, "go.mod"))
+		if err != nil {
+			return nil, err
+		}
+		modules = append(modules, Module{Dir: dir, Path: modPath})
+	}
+	sort.Slice(modules, func(i, j int) bool { return modules[i].Dir < modules[j].Dir })
+	return modules, nil
+}
+
+// ModulePath returns the module path declared in the given go.mod file.
+func ModulePath(gomod string) (string, error) {
+	data, err := os.ReadFile(gomod)
+	if err != nil {
+		return "", err
+	}
+	for _, d := range Directives(data, "module") {
+		return d.Args[0], nil
+	}
+	return "", fmt.Errorf("%s: no module directive", gomod)
+}
+
+// Owner returns the innermost module containing dir.
+func Owner(modules []Module, dir string) (Module, bool) {
+	var owner Module
+	found := false
+	for _, m := range modules {
+		if within(m.Dir, dir) && (!found || len(m.Dir) > len(owner.Dir)) {
+			owner = m
+			found = true
+		}
+	}
+	return owner, found
+}
+
+// Directive is a single go.mod or go.work directive, e.g. `use ./a` or `replace x => ../x`.
+type Directive struct {
+	// Line is the zero-based line index the directive appears on.
+	Line int
+	// Args are the unquoted fields following the keyword, with comments removed.
+	Args []string
+}
+
+// Directives returns all directives with the given keyword, including those inside `keyword ( ... )` blocks.
+func Directives(data []byte, keyword string) []Directive {
+	var directives []Directive
+	inBlock := false
+
+	for i, line := range strings.Split(string(data), "\n") {
+		fields := fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if inBlock {
+			if fields[0] == ")" {
+				inBlock = false
+				continue
+			}
+			directives = append(directives, Directive{Line: i, Args: fields})
+			continue
+		}
+		if fields[0] != keyword || len(fields) < 2 {
+			continue
+		}
+		if fields[1] == "(" {
+			inBlock = true
+			continue
+		}
+		directives = append(directives, Directive{Line: i, Args: fields[1:]})
+	}
+	return directives
+}
+
+// fields splits a go.mod line into fields, dropping comments and unquoting quoted strings.
+func fields(line string) []string {
+	if idx := strings.Index(line, "//"); idx >= 0 {
+		line = line[:idx]
+	}
+	var out []string
+	for _, f := range strings.Fields(line) {
+		if unquoted, err := strconv.Unquote(f); err == nil {
+			f = unquoted
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+// within reports whether path is root or lies below it.
+func within(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,121 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestFindNestedModules(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.mod"), "module example.com/root\n\ngo 1.21\n")
+	writeFile(t, filepath.Join(root, "tools", "go.mod"), "module \"example.com/tools\" // quoted\n")
+	writeFile(t, filepath.Join(root, "vendor", "x", "go.mod"), "module example.com/vendored\n")
+	writeFile(t, filepath.Join(root, "testdata", "go.mod"), "module example.com/testdata\n")
+
+	modules, err := Find(root)
+	if err != nil {
+		t.Fatalf("Find returned error: %v", err)
+	}
+
+	want := []Module{
+		{Dir: root, Path: "example.com/root"},
+		{Dir: filepath.Join(root, "tools"), Path: "example.com/tools"},
+	}
+	if !reflect.DeepEqual(modules, want) {
+		t.Fatalf("Find = %+v, want %+v", modules, want)
+	}
+}
+
+func TestFindUsesGoWork(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "go.work"), "go 1.21\n\nuse (\n\t./a\n\t./b // second\n)\nuse ../outside\n")
+	writeFile(t, filepath.Join(root, "a", "go.mod"), "module example.com/a\n")
+	writeFile(t, filepath.Join(root, "b", "go.mod"), "module example.com/b\n")
+	writeFile(t, filepath.Join(root, "c", "go.mod"), "module example.com/c\n")
+
+	modules, err := Find(root)
+	if err != nil {
+		t.Fatalf("Find returned error: %v", err)
+	}
+
+	want := []Module{
+		{Dir: filepath.Join(root, "a"), Path: "example.com/a"},
+		{Dir: filepath.Join(root, "b"), Path: "example.com/b"},
+	}
+	if !reflect.DeepEqual(modules, want) {
+		t.Fatalf("Find = %+v, want %+v", modules, want)
+	}
+}
+
+func TestFindWithoutModules(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, filepath.Join(root, "a.go"), "package a\n")
+
+	modules, err := Find(root)
+	if err != nil {
+		t.Fatalf("Find returned error: %v", err)
+	}
+	if len(modules) != 0 {
+		t.Fatalf("expected no modules, got %+v", modules)
+	}
+}
+
+func TestOwnerPicksInnermostModule(t *testing.T) {
+	root := filepath.Join(string(filepath.Separator), "repo")
+	modules := []Module{
+		{Dir: root, Path: "example.com/root"},
+		{Dir: filepath.Join(root, "tools"), Path: "example.com/tools"},
+	}
+
+	tests := []struct {
+		dir  string
+		want string
+		ok   bool
+	}{
+		{dir: filepath.Join(root, "pkg"), want: "example.com/root", ok: true},
+		{dir: filepath.Join(root, "tools", "cmd"), want: "example.com/tools", ok: true},
+		{dir: filepath.Join(root, "toolsx"), want: "example.com/root", ok: true},
+		{dir: filepath.Join(string(filepath.Separator), "elsewhere"), ok: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := Owner(modules, tt.dir)
+		if ok != tt.ok || got.Path != tt.want {
+			t.Fatalf("Owner(%q) = %q, %v; want %q, %v", tt.dir, got.Path, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestDirectives(t *testing.T) {
+	data := []byte(`module example.com/m
+
+replace example.com/a => ../a
+
+replace (
+	example.com/b v1.0.0 => ./b // local copy
+	example.com/c => example.com/d v1.2.3
+)
+`)
+
+	got := Directives(data, "replace")
+	want := []Directive{
+		{Line: 2, Args: []string{"example.com/a", "=>", "../a"}},
+		{Line: 5, Args: []string{"example.com/b", "v1.0.0", "=>", "./b"}},
+		{Line: 6, Args: []string{"example.com/c", "=>", "example.com/d", "v1.2.3"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Directives = %+v, want %+v", got, want)
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("failed to create directories for %s: %v", path, err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write file %s: %v", path, err)
+	}
+}
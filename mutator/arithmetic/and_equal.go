@@ -0,0 +1,28 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// AndEqual mutates &= to |=
+// AND_ASSIGN -> OR_ASSIGN
+type AndEqual struct{}
+
+func (m AndEqual) Name() string {
+	return "Arithmetic_AND_ASSIGN"
+}
+
+func (m AndEqual) CanMutate(node ast.Node) bool {
+	assign, ok := node.(*ast.AssignStmt)
+	return ok && assign.Tok == token.AND_ASSIGN
+}
+
+func (m AndEqual) Mutate(node ast.Node) ast.Node {
+	assign := node.(*ast.AssignStmt)
+	return &ast.AssignStmt{
+		Tok: token.OR_ASSIGN,
+		Lhs: assign.Lhs,
+		Rhs: assign.Rhs,
+	}
+}
@@ -0,0 +1,34 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// AndNot mutates &^ to &
+// AND_NOT -> AND
+type AndNot struct{}
+
+func (m AndNot) Name() string {
+	return "Arithmetic_AND_NOT"
+}
+
+func (m AndNot) CanMutate(node ast.Node) bool {
+	bin, ok := node.(*ast.BinaryExpr)
+	return ok && bin.Op == token.AND_NOT
+}
+
+// CanMutateWithType skips constant expressions, which are folded at compile time.
+func (m AndNot) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceOp(typeInfo, node.(*ast.BinaryExpr), token.AND)
+}
+
+func (m AndNot) Mutate(node ast.Node) ast.Node {
+	bin := node.(*ast.BinaryExpr)
+	return &ast.BinaryExpr{
+		Op: token.AND,
+		X:  bin.X,
+		Y:  bin.Y,
+	}
+}
@@ -0,0 +1,28 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// AndNotEqual mutates &^= to &=
+// AND_NOT_ASSIGN -> AND_ASSIGN
+type AndNotEqual struct{}
+
+func (m AndNotEqual) Name() string {
+	return "Arithmetic_AND_NOT_ASSIGN"
+}
+
+func (m AndNotEqual) CanMutate(node ast.Node) bool {
+	assign, ok := node.(*ast.AssignStmt)
+	return ok && assign.Tok == token.AND_NOT_ASSIGN
+}
+
+func (m AndNotEqual) Mutate(node ast.Node) ast.Node {
+	assign := node.(*ast.AssignStmt)
+	return &ast.AssignStmt{
+		Tok: token.AND_ASSIGN,
+		Lhs: assign.Lhs,
+		Rhs: assign.Rhs,
+	}
+}
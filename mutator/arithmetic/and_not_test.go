@@ -0,0 +1,96 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+func TestAndNotName(t *testing.T) {
+	mut := AndNot{}
+
+	if got, want := mut.Name(), "Arithmetic_AND_NOT"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestAndNotCanMutate(t *testing.T) {
+	mut := AndNot{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{
+			name: "binary AND NOT is mutable",
+			node: &ast.BinaryExpr{Op: token.AND_NOT},
+			want: true,
+		},
+		{
+			name: "binary AND is not mutable",
+			node: &ast.BinaryExpr{Op: token.AND},
+			want: false,
+		},
+		{
+			name: "non-binary expression",
+			node: &ast.Ident{Name: "x"},
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAndNotMutate(t *testing.T) {
+	mut := AndNot{}
+
+	original := &ast.BinaryExpr{
+		Op: token.AND_NOT,
+		X:  &ast.BasicLit{Kind: token.INT, Value: "1"},
+		Y:  &ast.BasicLit{Kind: token.INT, Value: "2"},
+	}
+
+	mutated := mut.Mutate(original)
+
+	mutatedExpr, ok := mutated.(*ast.BinaryExpr)
+	if !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.BinaryExpr", mutated)
+	}
+
+	if mutatedExpr == original {
+		t.Fatalf("Mutate() returned the original node, want a new node")
+	}
+
+	if mutatedExpr.Op != token.AND {
+		t.Fatalf("Mutate() Op = %v, want token.AND", mutatedExpr.Op)
+	}
+
+	if mutatedExpr.X != original.X {
+		t.Fatalf("Mutate() X = %p, want %p", mutatedExpr.X, original.X)
+	}
+
+	if mutatedExpr.Y != original.Y {
+		t.Fatalf("Mutate() Y = %p, want %p", mutatedExpr.Y, original.Y)
+	}
+}
+
+func TestAndNotCanMutateWithType(t *testing.T) {
+	exprs, info := checkExprs(t, "x &^ y", "x &^ 0", "7 &^ 2")
+
+	mut := AndNot{}
+	for i, want := range []bool{true, true, false} {
+		if got := mut.CanMutateWithType(exprs[i], info); got != want {
+			t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(exprs[i]), got, want)
+		}
+	}
+}
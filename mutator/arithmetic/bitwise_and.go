@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // BitwiseAnd mutates & to |
@@ -18,6 +19,11 @@ func (m BitwiseAnd) CanMutate(node ast.Node) bool {
 	return ok && bin.Op == token.AND
 }
 
+// CanMutateWithType skips constant expressions, which are folded at compile time.
+func (m BitwiseAnd) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceOp(typeInfo, node.(*ast.BinaryExpr), token.OR)
+}
+
 func (m BitwiseAnd) Mutate(node ast.Node) ast.Node {
 	bin := node.(*ast.BinaryExpr)
 	return &ast.BinaryExpr{
@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // BitwiseNot mutates ^x to x (removes bitwise NOT)
@@ -18,6 +19,12 @@ func (m BitwiseNot) CanMutate(node ast.Node) bool {
 	return ok && unary.Op == token.XOR
 }
 
+// CanMutateWithType skips constant operands such as ^uint(0), whose complement is folded at
+// compile time and may not fit the expected type once removed.
+func (m BitwiseNot) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && (typeInfo == nil || typeInfo.Types[node.(*ast.UnaryExpr)].Value == nil)
+}
+
 func (m BitwiseNot) Mutate(node ast.Node) ast.Node {
 	unary := node.(*ast.UnaryExpr)
 	return unary.X
@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 	"testing"
 )
 
@@ -62,3 +63,14 @@ func TestBitwiseNotMutate(t *testing.T) {
 		t.Fatalf("Mutate() = %p, want operand %p", mutated, operand)
 	}
 }
+
+func TestBitwiseNotCanMutateWithType(t *testing.T) {
+	exprs, info := checkExprs(t, "^x", "^uint(0)", "^u")
+
+	mut := BitwiseNot{}
+	for i, want := range []bool{true, false, true} {
+		if got := mut.CanMutateWithType(exprs[i], info); got != want {
+			t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(exprs[i]), got, want)
+		}
+	}
+}
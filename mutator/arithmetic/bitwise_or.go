@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // BitwiseOr mutates | to &
@@ -18,6 +19,11 @@ func (m BitwiseOr) CanMutate(node ast.Node) bool {
 	return ok && bin.Op == token.OR
 }
 
+// CanMutateWithType skips constant expressions such as flag masks, which are folded at compile time.
+func (m BitwiseOr) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceOp(typeInfo, node.(*ast.BinaryExpr), token.AND)
+}
+
 func (m BitwiseOr) Mutate(node ast.Node) ast.Node {
 	bin := node.(*ast.BinaryExpr)
 	return &ast.BinaryExpr{
@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // BitwiseXor mutates ^ to &
@@ -18,6 +19,11 @@ func (m BitwiseXor) CanMutate(node ast.Node) bool {
 	return ok && bin.Op == token.XOR
 }
 
+// CanMutateWithType skips constant expressions, which are folded at compile time.
+func (m BitwiseXor) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceOp(typeInfo, node.(*ast.BinaryExpr), token.AND)
+}
+
 func (m BitwiseXor) Mutate(node ast.Node) ast.Node {
 	bin := node.(*ast.BinaryExpr)
 	return &ast.BinaryExpr{
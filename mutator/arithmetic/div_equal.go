@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // DivEqual mutates /= to *=
@@ -18,6 +19,11 @@ func (m DivEqual) CanMutate(node ast.Node) bool {
 	return ok && assign.Tok == token.QUO_ASSIGN
 }
 
+// CanMutateWithType skips x /= 1, which is equivalent to x *= 1.
+func (m DivEqual) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceAssignOp(typeInfo, node.(*ast.AssignStmt), token.MUL)
+}
+
 func (m DivEqual) Mutate(node ast.Node) ast.Node {
 	assign := node.(*ast.AssignStmt)
 	return &ast.AssignStmt{
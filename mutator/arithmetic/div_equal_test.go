@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 	"testing"
 )
 
@@ -96,3 +97,14 @@ func sameExprSlices(a, b []ast.Expr) bool {
 	}
 	return true
 }
+
+func TestDivEqualCanMutateWithType(t *testing.T) {
+	body, info := checkStmts(t, `x /= y`, `x /= 1`, `f /= 2`)
+
+	mut := DivEqual{}
+	for i, want := range []bool{true, false, true} {
+		if got := mut.CanMutateWithType(body[i], info); got != want {
+			t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(body[i].(*ast.AssignStmt).Rhs[0]), got, want)
+		}
+	}
+}
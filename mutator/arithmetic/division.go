@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // Division mutates / to *
@@ -18,6 +19,12 @@ func (m Division) CanMutate(node ast.Node) bool {
 	return ok && bin.Op == token.QUO
 }
 
+// CanMutateWithType skips constant expressions, which are folded at compile time, and x / 1,
+// which is equivalent to x * 1.
+func (m Division) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceOp(typeInfo, node.(*ast.BinaryExpr), token.MUL)
+}
+
 func (m Division) Mutate(node ast.Node) ast.Node {
 	bin := node.(*ast.BinaryExpr)
 	return &ast.BinaryExpr{
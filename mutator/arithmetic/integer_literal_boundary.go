@@ -1,56 +1,324 @@
 package arithmetic
 
 import (
+	"fmt"
 	"go/ast"
+	"go/constant"
 	"go/token"
+	"go/types"
+	"math/big"
 	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
 )
 
-// IntegerLiteralBoundary mutates integer literals to nearby boundary values.
+// IntegerLiteralBoundary mutates integer and rune literals to nearby boundary values.
 // Rules:
 // 0 <-> 1; 1 <-> 0; n>1 => n-1; n<-1 => n+1; -1 => 0 (by rule n<-1 => n+1 gives 0?)
 // We special-case -1 to 0 to keep single-step mutation.
+// The literal keeps its notation: 0x10 -> 0x0f, 0b100 -> 0b011, 1_000 -> 999, 'b' -> 'a'.
 type IntegerLiteralBoundary struct{}
 
 func (m IntegerLiteralBoundary) Name() string { return "Arithmetic_INT_LITERAL_BOUNDARY" }
 
 func (m IntegerLiteralBoundary) CanMutate(node ast.Node) bool {
 	lit, ok := node.(*ast.BasicLit)
-	if !ok || lit.Kind != token.INT {
+	if !ok {
 		return false
 	}
-	// Ensure it parses as a base-10 integer; skip hex/oct/bin formats for simplicity
-	if _, err := strconv.Atoi(lit.Value); err != nil {
+	_, ok = mutateIntLiteral(lit)
+	return ok
+}
+
+// CanMutateWithType additionally skips literals whose new value doesn't compile once the
+// enclosing constant expression is folded: it must fit its type, mustn't become a zero divisor
+// or an out of range array index, and mustn't duplicate a map key or switch case. Array lengths
+// are skipped, and a literal in a constant declaration is checked at every use of the constant.
+func (m IntegerLiteralBoundary) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	lit, ok := node.(*ast.BasicLit)
+	if !ok {
 		return false
 	}
-	return true
+	mutated, ok := mutateIntLiteral(lit)
+	if !ok {
+		return false
+	}
+	if typeInfo == nil {
+		return true
+	}
+	return validConstant(typeInfo, lit, constant.MakeFromLiteral(mutated, lit.Kind, 0))
 }
 
 func (m IntegerLiteralBoundary) Mutate(node ast.Node) ast.Node {
 	lit, ok := node.(*ast.BasicLit)
-	if !ok || lit.Kind != token.INT {
+	if !ok {
 		return node
 	}
-	v, err := strconv.Atoi(lit.Value)
-	if err != nil {
+	mutated, ok := mutateIntLiteral(lit)
+	if !ok {
 		return node
 	}
+	return &ast.BasicLit{ValuePos: lit.ValuePos, Kind: lit.Kind, Value: mutated}
+}
 
-	var mutated string
-	switch v {
-	case 0:
-		mutated = "1"
-	case 1:
-		mutated = "0"
-	case -1:
-		mutated = "0"
-	default:
-		if v > 1 {
-			mutated = strconv.Itoa(v - 1)
-		} else { // v < -1
-			mutated = strconv.Itoa(v + 1)
+// boundaryValue returns the value v is mutated to.
+func boundaryValue(v *big.Int) *big.Int {
+	one := big.NewInt(1)
+	switch {
+	case v.Sign() == 0:
+		return one
+	case v.Sign() > 0:
+		return new(big.Int).Sub(v, one)
+	default: // v <= -1, so -1 becomes 0
+		return new(big.Int).Add(v, one)
+	}
+}
+
+// mutateIntLiteral returns the mutated source of an integer or rune literal, written in the
+// literal's notation. ok is false when the literal can't be parsed or the result isn't valid.
+func mutateIntLiteral(lit *ast.BasicLit) (string, bool) {
+	switch lit.Kind {
+	case token.INT:
+		return mutateInt(lit.Value)
+	case token.CHAR:
+		return mutateRune(lit.Value)
+	}
+	return "", false
+}
+
+// mutateInt mutates an integer literal, keeping its base prefix, digit case, digit grouping with
+// underscores and the zero padding of non-decimal literals.
+func mutateInt(value string) (string, bool) {
+	sign := ""
+	if rest, ok := strings.CutPrefix(value, "-"); ok {
+		sign, value = "-", rest
+	}
+
+	prefix, base := "", 10
+	if len(value) > 1 && value[0] == '0' {
+		switch value[1] {
+		case 'x', 'X':
+			prefix, base = value[:2], 16
+		case 'o', 'O':
+			prefix, base = value[:2], 8
+		case 'b', 'B':
+			prefix, base = value[:2], 2
+		default: // legacy octal such as 0755
+			prefix, base = "0", 8
+		}
+	}
+	digits := strings.TrimPrefix(value[len(prefix):], "_")
+	group := 0
+	if i := strings.LastIndexByte(digits, '_'); i >= 0 {
+		group = len(digits) - i - 1
+	}
+	digits = strings.ReplaceAll(digits, "_", "")
+
+	v, ok := new(big.Int).SetString(sign+digits, base)
+	if !ok {
+		return "", false
+	}
+	mutated := boundaryValue(v)
+
+	text := new(big.Int).Abs(mutated).Text(base)
+	if strings.ContainsAny(digits, "ABCDEF") {
+		text = strings.ToUpper(text)
+	}
+	if pad := len(digits) - len(text); pad > 0 && base != 10 {
+		// a decimal with leading zeros would be read as an octal literal
+		text = strings.Repeat("0", pad) + text
+	}
+	if group > 0 {
+		text = groupDigits(text, group)
+	}
+	if base == 8 && prefix == "0" && text == "0" {
+		// 00 is valid but reads oddly; a single 0 is the same value in any base
+		prefix = ""
+	}
+	if mutated.Sign() < 0 {
+		return "-" + prefix + text, true
+	}
+	return prefix + text, true
+}
+
+// groupDigits separates digits with underscores into groups of size, counted from the right.
+func groupDigits(digits string, size int) string {
+	var b strings.Builder
+	for i, r := range digits {
+		if i > 0 && (len(digits)-i)%size == 0 {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// mutateRune mutates a rune literal, keeping the escape form of the original where possible.
+func mutateRune(value string) (string, bool) {
+	if len(value) < 3 {
+		return "", false
+	}
+	r, _, tail, err := strconv.UnquoteChar(value[1:len(value)-1], '\'')
+	if err != nil || tail != "" {
+		return "", false
+	}
+	mutated := boundaryValue(big.NewInt(int64(r))).Int64()
+	if mutated > utf8.MaxRune || !utf8.ValidRune(rune(mutated)) {
+		return "", false
+	}
+
+	escape := strings.TrimPrefix(value, `'\`)
+	switch {
+	case escape == value:
+	case escape[0] == 'x' && mutated <= 0xff:
+		return fmt.Sprintf(`'\x%02x'`, mutated), true
+	case escape[0] == 'u' && mutated <= 0xffff:
+		return fmt.Sprintf(`'\u%04x'`, mutated), true
+	case escape[0] == 'U':
+		return fmt.Sprintf(`'\U%08x'`, mutated), true
+	case escape[0] >= '0' && escape[0] <= '7' && mutated <= 0xff:
+		return fmt.Sprintf(`'\%03o'`, mutated), true
+	}
+	return strconv.QuoteRune(rune(mutated)), true
+}
+
+// validConstant reports whether expr, a constant, can take the given value: the outermost
+// constant expression containing expr is evaluated with the new value and checked where it is used.
+func validConstant(typeInfo *types.Info, expr ast.Expr, value constant.Value) bool {
+	file := typeutil.File(typeInfo, expr.Pos())
+	if file == nil {
+		return true
+	}
+	expr, value, ok := typeutil.FoldConstant(typeInfo, file, expr, value)
+	if !ok {
+		return false
+	}
+	if isNumeric(value) && constant.Sign(value) == 0 && isDivisor(astutil.Parent(file, expr), expr) {
+		return false
+	}
+
+	switch p := astutil.Parent(file, expr).(type) {
+	case *ast.ArrayType:
+		return p.Len != expr
+	case *ast.IndexExpr:
+		return p.Index != expr || withinLength(typeInfo, p.X, value, false)
+	case *ast.SliceExpr:
+		return validSliceIndices(typeInfo, p, expr, value)
+	case *ast.CallExpr:
+		return validMakeSizes(typeInfo, p, expr, value)
+	case *ast.KeyValueExpr:
+		if composite, ok := astutil.Parent(file, p).(*ast.CompositeLit); ok && p.Key == expr && !withinLength(typeInfo, composite, value, false) {
+			return false
+		}
+	case *ast.ValueSpec:
+		for i, v := range p.Values {
+			if v == expr && i < len(p.Names) && !validConstantUses(typeInfo, p.Names[i], value) {
+				return false
+			}
+		}
+	}
+	return !typeutil.DuplicatesConstant(typeInfo, expr, value)
+}
+
+// validConstantUses reports whether every use of the constant declared by name can take the
+// given value.
+func validConstantUses(typeInfo *types.Info, name *ast.Ident, value constant.Value) bool {
+	obj, ok := typeInfo.Defs[name].(*types.Const)
+	if !ok {
+		return true
+	}
+	for ident, used := range typeInfo.Uses {
+		if used == obj && !validConstant(typeInfo, ident, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// isDivisor reports whether expr is the right operand of a division or remainder.
+func isDivisor(parent ast.Node, expr ast.Expr) bool {
+	switch p := parent.(type) {
+	case *ast.BinaryExpr:
+		return p.Y == expr && (p.Op == token.QUO || p.Op == token.REM)
+	case *ast.AssignStmt:
+		return len(p.Rhs) == 1 && p.Rhs[0] == expr && (p.Tok == token.QUO_ASSIGN || p.Tok == token.REM_ASSIGN)
+	}
+	return false
+}
+
+// withinLength reports whether the constant index is within the length of x when x is an array,
+// a pointer to an array or a constant string. The length itself is allowed when inclusive is set,
+// as for slice bounds. Indices into other values are only checked at run time.
+func withinLength(typeInfo *types.Info, x ast.Expr, index constant.Value, inclusive bool) bool {
+	var length int64
+	tv := typeInfo.Types[x]
+	switch {
+	case tv.Value != nil && tv.Value.Kind() == constant.String:
+		length = int64(len(constant.StringVal(tv.Value)))
+	case tv.Type != nil:
+		t := tv.Type.Underlying()
+		if ptr, ok := t.(*types.Pointer); ok {
+			t = ptr.Elem().Underlying()
+		}
+		array, ok := t.(*types.Array)
+		if !ok {
+			return true
 		}
+		length = array.Len()
+	default:
+		return true
+	}
+	op := token.LSS
+	if inclusive {
+		op = token.LEQ
+	}
+	return constant.Compare(constant.ToInt(index), op, constant.MakeInt64(length))
+}
+
+// validSliceIndices reports whether the constant indices of slice stay in order and within the
+// length of the sliced value once index takes the given value.
+func validSliceIndices(typeInfo *types.Info, slice *ast.SliceExpr, index ast.Expr, value constant.Value) bool {
+	if !withinLength(typeInfo, slice.X, value, true) {
+		return false
+	}
+	return ordered(typeInfo, index, value, slice.Low, slice.High, slice.Max)
+}
+
+// validMakeSizes reports whether the constant length of a make call stays within its constant
+// capacity once size takes the given value.
+func validMakeSizes(typeInfo *types.Info, call *ast.CallExpr, size ast.Expr, value constant.Value) bool {
+	ident, ok := ast.Unparen(call.Fun).(*ast.Ident)
+	if !ok || len(call.Args) != 3 {
+		return true
+	}
+	if builtin, ok := typeInfo.Uses[ident].(*types.Builtin); !ok || builtin.Name() != "make" {
+		return true
 	}
+	return ordered(typeInfo, size, value, call.Args[1], call.Args[2])
+}
 
-	return &ast.BasicLit{Kind: token.INT, Value: mutated}
+// ordered reports whether the constants among exprs are in non-decreasing order, with expr
+// replaced by value. Missing and non-constant expressions are ignored.
+func ordered(typeInfo *types.Info, expr ast.Expr, value constant.Value, exprs ...ast.Expr) bool {
+	var prev constant.Value
+	for _, e := range exprs {
+		if e == nil {
+			continue
+		}
+		v := typeInfo.Types[e].Value
+		if e == expr {
+			v = value
+		}
+		if v == nil {
+			continue
+		}
+		if prev != nil && constant.Compare(v, token.LSS, prev) {
+			return false
+		}
+		prev = v
+	}
+	return true
 }
@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 	"testing"
 )
 
@@ -109,3 +110,100 @@ func TestIntegerLiteralBoundaryMutate_NonIntegerIsNoop(t *testing.T) {
 		t.Fatalf("expected non-integer mutate to be noop; got %T", got)
 	}
 }
+
+func TestIntegerLiteralBoundaryMutate_KeepsNotation(t *testing.T) {
+	mut := IntegerLiteralBoundary{}
+
+	tests := []struct {
+		kind  token.Token
+		value string
+		want  string
+	}{
+		{kind: token.INT, value: "0x10", want: "0x0f"},
+		{kind: token.INT, value: "0XFF", want: "0XFE"},
+		{kind: token.INT, value: "0x0", want: "0x1"},
+		{kind: token.INT, value: "0o17", want: "0o16"},
+		{kind: token.INT, value: "0755", want: "0754"},
+		{kind: token.INT, value: "01", want: "0"},
+		{kind: token.INT, value: "0b100", want: "0b011"},
+		{kind: token.INT, value: "1_000_000", want: "999_999"},
+		{kind: token.INT, value: "10", want: "9"},
+		{kind: token.INT, value: "0x_FF_FF", want: "0xFF_FE"},
+		{kind: token.INT, value: "18446744073709551615", want: "18446744073709551614"},
+		{kind: token.CHAR, value: "'b'", want: "'a'"},
+		{kind: token.CHAR, value: "'\\x00'", want: "'\\x01'"},
+		{kind: token.CHAR, value: "'\\u00e9'", want: "'\\u00e8'"},
+		{kind: token.CHAR, value: "'\\101'", want: "'\\100'"},
+		{kind: token.CHAR, value: "'\\n'", want: "'\\t'"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			node := &ast.BasicLit{ValuePos: 3, Kind: tc.kind, Value: tc.value}
+			if !mut.CanMutate(node) {
+				t.Fatalf("CanMutate(%s) = false, want true", tc.value)
+			}
+
+			mutated, ok := mut.Mutate(node).(*ast.BasicLit)
+			if !ok {
+				t.Fatalf("Mutate(%s) returned %T, want *ast.BasicLit", tc.value, mutated)
+			}
+			if mutated.Kind != tc.kind || mutated.Value != tc.want {
+				t.Fatalf("Mutate(%s) = %v %s, want %v %s", tc.value, mutated.Kind, mutated.Value, tc.kind, tc.want)
+			}
+			if mutated.ValuePos != 3 {
+				t.Fatalf("Mutate(%s) position = %d, want 3", tc.value, mutated.ValuePos)
+			}
+		})
+	}
+}
+
+func TestIntegerLiteralBoundaryCanMutate_InvalidRuneResult(t *testing.T) {
+	mut := IntegerLiteralBoundary{}
+
+	// decrementing U+E000 would produce a surrogate half, which is not a valid rune literal
+	if mut.CanMutate(&ast.BasicLit{Kind: token.CHAR, Value: "'\\ue000'"}) {
+		t.Fatalf("expected CanMutate to be false for a rune mutating to a surrogate")
+	}
+}
+
+func TestIntegerLiteralBoundaryCanMutateWithType(t *testing.T) {
+	tests := []struct {
+		stmt string
+		want bool
+	}{
+		{"_ = x + 5", true},
+		{"_ = x / 1", false},
+		{"_ = x / (2 - 1)", false},
+		{"_ = x / (3 / 2)", true},
+		{"_ = x / (2 / 2)", false},
+		{"_ = uint8(0 + 255)", false},
+		{"_ = int8(127)", true},
+		{"var _ [3]int", false},
+		{"_ = a[3]", true},
+		{"_ = a[4:4]", true},
+		{"_ = a[0:0]", false},
+		{`_ = "abc"[2]`, true},
+		{"_ = make([]int, 2, 2)", true},
+		{"_ = make([]int, 0, 0)", false},
+		{"switch x {\n\tcase 1, 0:\n\t}", false},
+		{"const n = 1; _ = x / n", false},
+		{"const m = 2; _ = x / m", true},
+	}
+
+	for _, tc := range tests {
+		body, info := checkStmts(t, tc.stmt)
+		var lit *ast.BasicLit
+		ast.Inspect(body[0], func(n ast.Node) bool {
+			if l, ok := n.(*ast.BasicLit); ok && lit == nil && l.Kind == token.INT {
+				lit = l
+			}
+			return lit == nil
+		})
+
+		mut := IntegerLiteralBoundary{}
+		if got := mut.CanMutateWithType(lit, info); got != tc.want {
+			t.Fatalf("CanMutateWithType(%s in %q) = %v, want %v", types.ExprString(lit), tc.stmt, got, tc.want)
+		}
+	}
+}
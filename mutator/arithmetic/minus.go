@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // Minus mutates - to +
@@ -18,6 +19,12 @@ func (m Minus) CanMutate(node ast.Node) bool {
 	return ok && bin.Op == token.SUB
 }
 
+// CanMutateWithType skips constant expressions, which are folded at compile time, and x - 0,
+// which is equivalent to x + 0.
+func (m Minus) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceOp(typeInfo, node.(*ast.BinaryExpr), token.ADD)
+}
+
 func (m Minus) Mutate(node ast.Node) ast.Node {
 	bin := node.(*ast.BinaryExpr)
 	return &ast.BinaryExpr{
@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // MinusEqual mutates -= to +=
@@ -18,6 +19,11 @@ func (m MinusEqual) CanMutate(node ast.Node) bool {
 	return ok && assign.Tok == token.SUB_ASSIGN
 }
 
+// CanMutateWithType skips x -= 0, which is equivalent to x += 0.
+func (m MinusEqual) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceAssignOp(typeInfo, node.(*ast.AssignStmt), token.ADD)
+}
+
 func (m MinusEqual) Mutate(node ast.Node) ast.Node {
 	assign := node.(*ast.AssignStmt)
 	return &ast.AssignStmt{
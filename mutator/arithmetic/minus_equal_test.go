@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 	"testing"
 )
 
@@ -84,3 +85,14 @@ func TestMinusEqualMutate(t *testing.T) {
 		t.Fatalf("Mutate() Rhs = %#v, want %#v", mutatedAssign.Rhs, rhs)
 	}
 }
+
+func TestMinusEqualCanMutateWithType(t *testing.T) {
+	body, info := checkStmts(t, `x -= y`, `x -= 0`, `d -= Second`)
+
+	mut := MinusEqual{}
+	for i, want := range []bool{true, false, true} {
+		if got := mut.CanMutateWithType(body[i], info); got != want {
+			t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(body[i].(*ast.AssignStmt).Rhs[0]), got, want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 	"testing"
 )
 
@@ -82,3 +83,14 @@ func TestMinusMutate(t *testing.T) {
 		t.Fatalf("Mutate() Y = %p, want %p", mutatedExpr.Y, original.Y)
 	}
 }
+
+func TestMinusCanMutateWithType(t *testing.T) {
+	exprs, info := checkExprs(t, "x - y", "x - 0", "Second - 1", "f - 0.5")
+
+	mut := Minus{}
+	for i, want := range []bool{true, false, false, true} {
+		if got := mut.CanMutateWithType(exprs[i], info); got != want {
+			t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(exprs[i]), got, want)
+		}
+	}
+}
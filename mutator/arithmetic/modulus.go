@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // Modulus mutates % to *
@@ -17,6 +18,11 @@ func (m Modulus) CanMutate(node ast.Node) bool {
 	return ok && bin.Op == token.REM
 }
 
+// CanMutateWithType skips constant expressions, which are folded at compile time.
+func (m Modulus) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceOp(typeInfo, node.(*ast.BinaryExpr), token.MUL)
+}
+
 func (m Modulus) Mutate(node ast.Node) ast.Node {
 	bin := node.(*ast.BinaryExpr)
 	return &ast.BinaryExpr{
@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // MulEqual mutates *= to /=
@@ -18,6 +19,12 @@ func (m MulEqual) CanMutate(node ast.Node) bool {
 	return ok && assign.Tok == token.MUL_ASSIGN
 }
 
+// CanMutateWithType skips x *= 0, which would divide by a constant zero, and x *= 1, which is
+// equivalent to x /= 1.
+func (m MulEqual) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceAssignOp(typeInfo, node.(*ast.AssignStmt), token.QUO)
+}
+
 func (m MulEqual) Mutate(node ast.Node) ast.Node {
 	assign := node.(*ast.AssignStmt)
 	return &ast.AssignStmt{
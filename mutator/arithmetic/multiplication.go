@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // Multiplication mutates * to /
@@ -18,6 +19,12 @@ func (m Multiplication) CanMutate(node ast.Node) bool {
 	return ok && bin.Op == token.MUL
 }
 
+// CanMutateWithType skips constant expressions such as time.Second * 5, which are folded at
+// compile time, x * 0, which would divide by a constant zero, and x * 1, which is equivalent to x / 1.
+func (m Multiplication) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceOp(typeInfo, node.(*ast.BinaryExpr), token.QUO)
+}
+
 func (m Multiplication) Mutate(node ast.Node) ast.Node {
 	bin := node.(*ast.BinaryExpr)
 	return &ast.BinaryExpr{
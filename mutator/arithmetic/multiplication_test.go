@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 	"testing"
 )
 
@@ -82,3 +83,14 @@ func TestMultiplicationMutate(t *testing.T) {
 		t.Fatalf("Mutate() Y = %p, want %p", mutatedExpr.Y, original.Y)
 	}
 }
+
+func TestMultiplicationCanMutateWithType(t *testing.T) {
+	exprs, info := checkExprs(t, "x * y", "Second * 5", "d * Second", "x * 0", "x * 1", "f * 2")
+
+	mut := Multiplication{}
+	for i, want := range []bool{true, false, true, false, false, true} {
+		if got := mut.CanMutateWithType(exprs[i], info); got != want {
+			t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(exprs[i]), got, want)
+		}
+	}
+}
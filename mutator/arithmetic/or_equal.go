@@ -0,0 +1,28 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// OrEqual mutates |= to &=
+// OR_ASSIGN -> AND_ASSIGN
+type OrEqual struct{}
+
+func (m OrEqual) Name() string {
+	return "Arithmetic_OR_ASSIGN"
+}
+
+func (m OrEqual) CanMutate(node ast.Node) bool {
+	assign, ok := node.(*ast.AssignStmt)
+	return ok && assign.Tok == token.OR_ASSIGN
+}
+
+func (m OrEqual) Mutate(node ast.Node) ast.Node {
+	assign := node.(*ast.AssignStmt)
+	return &ast.AssignStmt{
+		Tok: token.AND_ASSIGN,
+		Lhs: assign.Lhs,
+		Rhs: assign.Rhs,
+	}
+}
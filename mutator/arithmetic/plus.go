@@ -2,6 +2,7 @@ package arithmetic
 
 import (
 	"go/ast"
+	"go/constant"
 	"go/token"
 	"go/types"
 )
@@ -36,16 +37,12 @@ func (m Plus) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
 		return true
 	}
 
-	// Check if either operand is a string
-	xBasic, xOk := xType.Underlying().(*types.Basic)
-	yBasic, yOk := yType.Underlying().(*types.Basic)
-
-	if (xOk && xBasic.Kind() == types.String) || (yOk && yBasic.Kind() == types.String) {
+	if isString(xType) || isString(yType) {
 		// This is string concatenation, don't mutate
 		return false
 	}
 
-	return true
+	return canReplaceOp(typeInfo, bin, token.SUB)
 }
 
 func (m Plus) Mutate(node ast.Node) ast.Node {
@@ -56,3 +53,72 @@ func (m Plus) Mutate(node ast.Node) ast.Node {
 		Y:  bin.Y,
 	}
 }
+
+// isString reports whether t is a string type.
+func isString(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
+
+// canReplaceOp reports whether the operator of bin can be replaced by op. Constant expressions
+// are skipped since they are folded at compile time, where a mutant may overflow or divide by
+// zero. A constant zero mustn't become a divisor, and swapping two operators that are both
+// neutral for a constant right operand, such as x + 0 and x - 0, gives an equivalent mutant.
+func canReplaceOp(typeInfo *types.Info, bin *ast.BinaryExpr, op token.Token) bool {
+	if typeInfo == nil {
+		return true
+	}
+	if typeInfo.Types[bin].Value != nil {
+		return false
+	}
+	return canReplaceOperand(typeInfo.Types[bin.Y].Value, bin.Op, op)
+}
+
+// canReplaceAssignOp is canReplaceOp for an assignment operation such as x += y. op is the
+// binary operator of the replacement, e.g. token.SUB for -=.
+func canReplaceAssignOp(typeInfo *types.Info, assign *ast.AssignStmt, op token.Token) bool {
+	if typeInfo == nil || len(assign.Rhs) != 1 {
+		return true
+	}
+	return canReplaceOperand(typeInfo.Types[assign.Rhs[0]].Value, assignOp(assign.Tok), op)
+}
+
+// canReplaceOperand reports whether the operator from can be replaced by to for a right operand
+// y, which is nil unless the operand is constant.
+func canReplaceOperand(y constant.Value, from, to token.Token) bool {
+	if y == nil || !isNumeric(y) {
+		return true
+	}
+	if (to == token.QUO || to == token.REM) && constant.Sign(y) == 0 {
+		return false
+	}
+	return !(isNeutral(from, y) && isNeutral(to, y))
+}
+
+// isNeutral reports whether y is the neutral right operand of op, leaving the left one unchanged.
+func isNeutral(op token.Token, y constant.Value) bool {
+	switch op {
+	case token.ADD, token.SUB, token.OR, token.XOR, token.SHL, token.SHR, token.AND_NOT:
+		return constant.Sign(y) == 0
+	case token.MUL, token.QUO:
+		return constant.Compare(y, token.EQL, constant.MakeInt64(1))
+	}
+	return false
+}
+
+// isNumeric reports whether v is a numeric constant.
+func isNumeric(v constant.Value) bool {
+	switch v.Kind() {
+	case constant.Int, constant.Float, constant.Complex:
+		return true
+	}
+	return false
+}
+
+// assignOp returns the binary operator of an assignment operation, e.g. token.ADD for +=.
+func assignOp(tok token.Token) token.Token {
+	return tok - token.ADD_ASSIGN + token.ADD
+}
@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // PlusEqual mutates += to -=
@@ -18,6 +19,19 @@ func (m PlusEqual) CanMutate(node ast.Node) bool {
 	return ok && assign.Tok == token.ADD_ASSIGN
 }
 
+// CanMutateWithType skips string concatenations, which can't be subtracted, and x += 0, which
+// is equivalent to x -= 0.
+func (m PlusEqual) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	assign := node.(*ast.AssignStmt)
+	if len(assign.Lhs) == 1 && isString(typeInfo.TypeOf(assign.Lhs[0])) {
+		return false
+	}
+	return canReplaceAssignOp(typeInfo, assign, token.SUB)
+}
+
 func (m PlusEqual) Mutate(node ast.Node) ast.Node {
 	assign := node.(*ast.AssignStmt)
 	return &ast.AssignStmt{
@@ -2,9 +2,13 @@ package arithmetic
 
 import (
 	"go/ast"
+	"go/constant"
 	"go/token"
 	"go/types"
+	"strings"
 	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
 )
 
 func TestPlusName(t *testing.T) {
@@ -144,6 +148,36 @@ func TestPlusCanMutateWithType(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "constant int expression is not mutable",
+			build: func() (ast.Node, *types.Info) {
+				x := &ast.BasicLit{Kind: token.INT, Value: "1"}
+				y := &ast.BasicLit{Kind: token.INT, Value: "2"}
+				bin := &ast.BinaryExpr{Op: token.ADD, X: x, Y: y}
+				info := &types.Info{Types: map[ast.Expr]types.TypeAndValue{
+					x:   {Type: types.Typ[types.UntypedInt], Value: constant.MakeInt64(1)},
+					y:   {Type: types.Typ[types.UntypedInt], Value: constant.MakeInt64(2)},
+					bin: {Type: types.Typ[types.UntypedInt], Value: constant.MakeInt64(3)},
+				}}
+				return bin, info
+			},
+			want: false,
+		},
+		{
+			name: "adding a constant zero is not mutable",
+			build: func() (ast.Node, *types.Info) {
+				x := &ast.Ident{Name: "x"}
+				y := &ast.BasicLit{Kind: token.INT, Value: "0"}
+				bin := &ast.BinaryExpr{Op: token.ADD, X: x, Y: y}
+				info := &types.Info{Types: map[ast.Expr]types.TypeAndValue{
+					x:   {Type: types.Typ[types.Int]},
+					y:   {Type: types.Typ[types.Int], Value: constant.MakeInt64(0)},
+					bin: {Type: types.Typ[types.Int]},
+				}}
+				return bin, info
+			},
+			want: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -190,3 +224,47 @@ func TestPlusMutate(t *testing.T) {
 		t.Fatalf("Mutate() Y = %p, want %p", mutatedExpr.Y, original.Y)
 	}
 }
+
+func TestPlusCanMutateWithTypeChecked(t *testing.T) {
+	exprs, info := checkExprs(t, "x + y", "x + 0", `s + "!"`, "Second + 1", "f + 0.5")
+
+	mut := Plus{}
+	for i, want := range []bool{true, false, false, false, true} {
+		if got := mut.CanMutateWithType(exprs[i], info); got != want {
+			t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(exprs[i]), got, want)
+		}
+	}
+}
+
+// checkStmts type-checks stmts in the body of a function with parameters of various types
+// and returns them.
+func checkStmts(t *testing.T, stmts ...string) ([]ast.Stmt, *types.Info) {
+	t.Helper()
+	src := `package sample
+
+type Duration int64
+
+const Second Duration = 1e9
+
+func sample(x, y int, u uint8, f float64, s string, d Duration, a [4]int) {
+	` + strings.Join(stmts, "\n\t") + `
+}
+`
+	file, info := testutil.Check(t, token.NewFileSet(), src)
+	return file.Decls[len(file.Decls)-1].(*ast.FuncDecl).Body.List, info
+}
+
+// checkExprs type-checks exprs as the right-hand side of blank assignments and returns them.
+func checkExprs(t *testing.T, exprs ...string) ([]ast.Expr, *types.Info) {
+	t.Helper()
+	stmts := make([]string, len(exprs))
+	for i, expr := range exprs {
+		stmts[i] = "_ = " + expr
+	}
+	body, info := checkStmts(t, stmts...)
+	checked := make([]ast.Expr, len(body))
+	for i, stmt := range body {
+		checked[i] = stmt.(*ast.AssignStmt).Rhs[0]
+	}
+	return checked, info
+}
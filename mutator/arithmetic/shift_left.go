@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // ShiftLeft mutates << to >>
@@ -18,6 +19,12 @@ func (m ShiftLeft) CanMutate(node ast.Node) bool {
 	return ok && bin.Op == token.SHL
 }
 
+// CanMutateWithType skips constant expressions such as 1 << 10, which are folded at compile time
+// and may overflow, and x << 0, which is equivalent to x >> 0.
+func (m ShiftLeft) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceOp(typeInfo, node.(*ast.BinaryExpr), token.SHR)
+}
+
 func (m ShiftLeft) Mutate(node ast.Node) ast.Node {
 	bin := node.(*ast.BinaryExpr)
 	return &ast.BinaryExpr{
@@ -0,0 +1,61 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ShiftLeftEqual mutates <<= to >>=
+// SHL_ASSIGN -> SHR_ASSIGN
+type ShiftLeftEqual struct{}
+
+func (m ShiftLeftEqual) Name() string {
+	return "Arithmetic_SHL_ASSIGN"
+}
+
+func (m ShiftLeftEqual) CanMutate(node ast.Node) bool {
+	assign, ok := node.(*ast.AssignStmt)
+	return ok && assign.Tok == token.SHL_ASSIGN
+}
+
+// CanMutateWithType additionally skips constant shift counts at least as large as an unsigned
+// shifted variable, which leave zero in either direction, and x <<= 0, which is a no-op either way.
+func (m ShiftLeftEqual) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	assign := node.(*ast.AssignStmt)
+	return !shiftsOut(typeInfo, assign) && canReplaceAssignOp(typeInfo, assign, token.SHR)
+}
+
+func (m ShiftLeftEqual) Mutate(node ast.Node) ast.Node {
+	assign := node.(*ast.AssignStmt)
+	return &ast.AssignStmt{
+		Tok: token.SHR_ASSIGN,
+		Lhs: assign.Lhs,
+		Rhs: assign.Rhs,
+	}
+}
+
+// shiftsOut reports whether the shift assignment's count is a constant at least as large as
+// the number of bits of the shifted variable, which is unsigned. Signed variables are left out:
+// shifting a negative value right leaves -1 rather than zero.
+func shiftsOut(typeInfo *types.Info, assign *ast.AssignStmt) bool {
+	if typeInfo == nil || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return false
+	}
+	count := typeInfo.Types[assign.Rhs[0]].Value
+	t := typeInfo.TypeOf(assign.Lhs[0])
+	if count == nil || t == nil {
+		return false
+	}
+	if basic, ok := t.Underlying().(*types.Basic); !ok || basic.Info()&types.IsUnsigned == 0 {
+		return false
+	}
+	n, ok := constant.Int64Val(constant.ToInt(count))
+	return !ok || n >= 8*typeutil.Sizes().Sizeof(t)
+}
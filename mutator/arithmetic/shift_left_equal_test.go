@@ -0,0 +1,111 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestShiftLeftEqualName(t *testing.T) {
+	mut := ShiftLeftEqual{}
+
+	if got, want := mut.Name(), "Arithmetic_SHL_ASSIGN"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestShiftLeftEqualCanMutate(t *testing.T) {
+	mut := ShiftLeftEqual{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{
+			name: "left shift assignment is mutable",
+			node: &ast.AssignStmt{Tok: token.SHL_ASSIGN},
+			want: true,
+		},
+		{
+			name: "right shift assignment is not mutable",
+			node: &ast.AssignStmt{Tok: token.SHR_ASSIGN},
+			want: false,
+		},
+		{
+			name: "non-assignment node",
+			node: &ast.Ident{Name: "x"},
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestShiftLeftEqualMutate(t *testing.T) {
+	mut := ShiftLeftEqual{}
+
+	lhs := []ast.Expr{&ast.Ident{Name: "x"}}
+	rhs := []ast.Expr{&ast.BasicLit{Kind: token.INT, Value: "3"}}
+	original := &ast.AssignStmt{
+		Tok: token.SHL_ASSIGN,
+		Lhs: lhs,
+		Rhs: rhs,
+	}
+
+	mutated := mut.Mutate(original)
+
+	mutatedAssign, ok := mutated.(*ast.AssignStmt)
+	if !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.AssignStmt", mutated)
+	}
+
+	if mutatedAssign == original {
+		t.Fatalf("Mutate() returned the original node, want a new node")
+	}
+
+	if mutatedAssign.Tok != token.SHR_ASSIGN {
+		t.Fatalf("Mutate() Tok = %v, want token.SHR_ASSIGN", mutatedAssign.Tok)
+	}
+
+	if !sameExprSlices(mutatedAssign.Lhs, lhs) {
+		t.Fatalf("Mutate() Lhs = %#v, want %#v", mutatedAssign.Lhs, lhs)
+	}
+
+	if !sameExprSlices(mutatedAssign.Rhs, rhs) {
+		t.Fatalf("Mutate() Rhs = %#v, want %#v", mutatedAssign.Rhs, rhs)
+	}
+}
+
+func TestShiftLeftEqualCanMutateWithType(t *testing.T) {
+	src := `package sample
+
+func shift(x uint8, y int8, n int) {
+	x <<= 3
+	x <<= 8
+	x <<= n
+	x <<= 0
+	y <<= 8
+}
+`
+	file, info := testutil.Check(t, token.NewFileSet(), src)
+	body := file.Decls[0].(*ast.FuncDecl).Body.List
+
+	mut := ShiftLeftEqual{}
+	for i, want := range []bool{true, false, true, false, true} {
+		if got := mut.CanMutateWithType(body[i], info); got != want {
+			t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(body[i].(*ast.AssignStmt).Rhs[0]), got, want)
+		}
+	}
+}
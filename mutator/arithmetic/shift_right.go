@@ -3,6 +3,7 @@ package arithmetic
 import (
 	"go/ast"
 	"go/token"
+	"go/types"
 )
 
 // ShiftRight mutates >> to <<
@@ -18,6 +19,12 @@ func (m ShiftRight) CanMutate(node ast.Node) bool {
 	return ok && bin.Op == token.SHR
 }
 
+// CanMutateWithType skips constant expressions, which are folded at compile time and may overflow
+// when shifted the other way, and x >> 0, which is equivalent to x << 0.
+func (m ShiftRight) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceOp(typeInfo, node.(*ast.BinaryExpr), token.SHL)
+}
+
 func (m ShiftRight) Mutate(node ast.Node) ast.Node {
 	bin := node.(*ast.BinaryExpr)
 	return &ast.BinaryExpr{
@@ -0,0 +1,39 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// ShiftRightEqual mutates >>= to <<=
+// SHR_ASSIGN -> SHL_ASSIGN
+type ShiftRightEqual struct{}
+
+func (m ShiftRightEqual) Name() string {
+	return "Arithmetic_SHR_ASSIGN"
+}
+
+func (m ShiftRightEqual) CanMutate(node ast.Node) bool {
+	assign, ok := node.(*ast.AssignStmt)
+	return ok && assign.Tok == token.SHR_ASSIGN
+}
+
+// CanMutateWithType additionally skips constant shift counts at least as large as an unsigned
+// shifted variable, which leave zero in either direction, and x >>= 0, which is a no-op either way.
+func (m ShiftRightEqual) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	assign := node.(*ast.AssignStmt)
+	return !shiftsOut(typeInfo, assign) && canReplaceAssignOp(typeInfo, assign, token.SHL)
+}
+
+func (m ShiftRightEqual) Mutate(node ast.Node) ast.Node {
+	assign := node.(*ast.AssignStmt)
+	return &ast.AssignStmt{
+		Tok: token.SHL_ASSIGN,
+		Lhs: assign.Lhs,
+		Rhs: assign.Rhs,
+	}
+}
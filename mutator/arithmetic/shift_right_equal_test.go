@@ -0,0 +1,98 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+func TestShiftRightEqualName(t *testing.T) {
+	mut := ShiftRightEqual{}
+
+	if got, want := mut.Name(), "Arithmetic_SHR_ASSIGN"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestShiftRightEqualCanMutate(t *testing.T) {
+	mut := ShiftRightEqual{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{
+			name: "right shift assignment is mutable",
+			node: &ast.AssignStmt{Tok: token.SHR_ASSIGN},
+			want: true,
+		},
+		{
+			name: "left shift assignment is not mutable",
+			node: &ast.AssignStmt{Tok: token.SHL_ASSIGN},
+			want: false,
+		},
+		{
+			name: "non-assignment node",
+			node: &ast.Ident{Name: "x"},
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestShiftRightEqualMutate(t *testing.T) {
+	mut := ShiftRightEqual{}
+
+	lhs := []ast.Expr{&ast.Ident{Name: "x"}}
+	rhs := []ast.Expr{&ast.BasicLit{Kind: token.INT, Value: "3"}}
+	original := &ast.AssignStmt{
+		Tok: token.SHR_ASSIGN,
+		Lhs: lhs,
+		Rhs: rhs,
+	}
+
+	mutated := mut.Mutate(original)
+
+	mutatedAssign, ok := mutated.(*ast.AssignStmt)
+	if !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.AssignStmt", mutated)
+	}
+
+	if mutatedAssign == original {
+		t.Fatalf("Mutate() returned the original node, want a new node")
+	}
+
+	if mutatedAssign.Tok != token.SHL_ASSIGN {
+		t.Fatalf("Mutate() Tok = %v, want token.SHL_ASSIGN", mutatedAssign.Tok)
+	}
+
+	if !sameExprSlices(mutatedAssign.Lhs, lhs) {
+		t.Fatalf("Mutate() Lhs = %#v, want %#v", mutatedAssign.Lhs, lhs)
+	}
+
+	if !sameExprSlices(mutatedAssign.Rhs, rhs) {
+		t.Fatalf("Mutate() Rhs = %#v, want %#v", mutatedAssign.Rhs, rhs)
+	}
+}
+
+func TestShiftRightEqualCanMutateWithType(t *testing.T) {
+	body, info := checkStmts(t, `x >>= u`, `x >>= 0`, `u >>= 8`)
+
+	mut := ShiftRightEqual{}
+	for i, want := range []bool{true, false, false} {
+		if got := mut.CanMutateWithType(body[i], info); got != want {
+			t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(body[i].(*ast.AssignStmt).Rhs[0]), got, want)
+		}
+	}
+}
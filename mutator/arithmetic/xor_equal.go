@@ -0,0 +1,28 @@
+package arithmetic
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// XorEqual mutates ^= to &=
+// XOR_ASSIGN -> AND_ASSIGN
+type XorEqual struct{}
+
+func (m XorEqual) Name() string {
+	return "Arithmetic_XOR_ASSIGN"
+}
+
+func (m XorEqual) CanMutate(node ast.Node) bool {
+	assign, ok := node.(*ast.AssignStmt)
+	return ok && assign.Tok == token.XOR_ASSIGN
+}
+
+func (m XorEqual) Mutate(node ast.Node) ast.Node {
+	assign := node.(*ast.AssignStmt)
+	return &ast.AssignStmt{
+		Tok: token.AND_ASSIGN,
+		Lhs: assign.Lhs,
+		Rhs: assign.Rhs,
+	}
+}
@@ -0,0 +1,100 @@
+package branch
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// CaseRemoval removes individual case clauses from switch and select statements.
+// switch x { case 1: a; case 2: b } -> switch x { case 2: b }
+// Default clauses of select statements and terminating default clauses of switch
+// statements are kept, since removing them can block forever or leave a function without a return.
+type CaseRemoval struct{}
+
+func (m CaseRemoval) Name() string {
+	return "Branch_CASE_REMOVE"
+}
+
+func (m CaseRemoval) CanMutate(node ast.Node) bool {
+	switch clause := node.(type) {
+	case *ast.CaseClause:
+		return clause.List != nil || !astutil.Terminates(&ast.BlockStmt{List: clause.Body})
+	case *ast.CommClause:
+		return clause.Comm != nil
+	}
+	return false
+}
+
+// CanMutateWithType additionally checks that removing the clause keeps the mutant compiling:
+// no other clause falls through into it, it isn't the only clause using a type switch variable,
+// and nothing outside the clause is left unused.
+func (m CaseRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	if len(typeutil.Unused(typeInfo, node)) > 0 {
+		return false
+	}
+
+	file := typeutil.File(typeInfo, node.Pos())
+	if file == nil {
+		return true
+	}
+	body, ok := astutil.Parent(file, node).(*ast.BlockStmt)
+	if !ok {
+		return true
+	}
+	for i, clause := range body.List {
+		if clause != node {
+			continue
+		}
+		if i > 0 && fallsThrough(body.List[i-1]) {
+			return false
+		}
+	}
+	if typeSwitch, ok := astutil.Parent(file, body).(*ast.TypeSwitchStmt); ok {
+		if _, ok := typeSwitch.Assign.(*ast.AssignStmt); ok {
+			return typeSwitchVarUsedElsewhere(body, node, typeInfo)
+		}
+	}
+	return true
+}
+
+// Mutate returns nil, which removes the clause from its switch or select statement.
+func (m CaseRemoval) Mutate(node ast.Node) ast.Node {
+	return nil
+}
+
+// fallsThrough reports whether a case clause ends with a fallthrough statement.
+func fallsThrough(stmt ast.Stmt) bool {
+	clause, ok := stmt.(*ast.CaseClause)
+	if !ok || len(clause.Body) == 0 {
+		return false
+	}
+	branch, ok := clause.Body[len(clause.Body)-1].(*ast.BranchStmt)
+	return ok && branch.Tok == token.FALLTHROUGH
+}
+
+// typeSwitchVarUsedElsewhere reports whether a clause other than removed uses the type switch
+// variable, which must be used at least once.
+func typeSwitchVarUsedElsewhere(body *ast.BlockStmt, removed ast.Node, typeInfo *types.Info) bool {
+	vars := make(map[types.Object]bool)
+	for _, clause := range body.List {
+		if clause == removed {
+			continue
+		}
+		if obj, ok := typeInfo.Implicits[clause]; ok {
+			vars[obj] = true
+		}
+	}
+	for _, obj := range typeInfo.Uses {
+		if vars[obj] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package branch
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestCaseRemovalName(t *testing.T) {
+	mut := CaseRemoval{}
+
+	if got, want := mut.Name(), "Branch_CASE_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestCaseRemovalCanMutate(t *testing.T) {
+	mut := CaseRemoval{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "case clause is mutable", node: &ast.CaseClause{List: []ast.Expr{ast.NewIdent("x")}}, want: true},
+		{name: "default clause is mutable", node: &ast.CaseClause{}, want: true},
+		{name: "terminating default clause is not mutable", node: &ast.CaseClause{Body: []ast.Stmt{&ast.ReturnStmt{}}}, want: false},
+		{name: "select case is mutable", node: &ast.CommClause{Comm: &ast.ExprStmt{X: ast.NewIdent("x")}}, want: true},
+		{name: "select default is not mutable", node: &ast.CommClause{}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCaseRemovalCanMutateWithType(t *testing.T) {
+	mut := CaseRemoval{}
+	cases, info := testutil.Nodes[*ast.CaseClause](t, source)
+	comms, _ := testutil.Nodes[*ast.CommClause](t, source)
+
+	tests := []struct {
+		name   string
+		clause ast.Node
+		want   bool
+	}{
+		{name: "falling through clause is mutable", clause: cases[0], want: true},
+		{name: "clause fallen through into is not mutable", clause: cases[1], want: false},
+		{name: "terminating default is not mutable", clause: cases[2], want: false},
+		{name: "type switch clause is mutable when another uses the variable", clause: cases[3], want: true},
+		{name: "select case is mutable", clause: comms[0], want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutateWithType(tc.clause, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCaseRemovalMutate(t *testing.T) {
+	mut := CaseRemoval{}
+
+	if mutated := mut.Mutate(&ast.CaseClause{}); mutated != nil {
+		t.Fatalf("Mutate() = %T, want nil", mutated)
+	}
+}
@@ -0,0 +1,52 @@
+package branch
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ElseBodyRemoval removes the else branch of if statements.
+// if cond { ... } else { ... } -> if cond { ... }
+// if cond { ... } else if other { ... } -> if cond { ... }
+type ElseBodyRemoval struct{}
+
+func (m ElseBodyRemoval) Name() string {
+	return "Branch_ELSE_EMPTY"
+}
+
+func (m ElseBodyRemoval) CanMutate(node ast.Node) bool {
+	stmt, ok := node.(*ast.IfStmt)
+	if !ok || stmt.Else == nil || astutil.Terminates(stmt) {
+		return false
+	}
+	block, ok := stmt.Else.(*ast.BlockStmt)
+	return !ok || len(block.List) > 0
+}
+
+// CanMutateWithType additionally skips else branches that are the only use of a package a blank
+// assignment can't keep in use, as in var v pkg.T.
+func (m ElseBodyRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && typeutil.Keepable(typeInfo, node.(*ast.IfStmt).Else)
+}
+
+func (m ElseBodyRemoval) Mutate(node ast.Node) ast.Node {
+	stmt := node.(*ast.IfStmt)
+	cloned := *stmt
+	cloned.Else = nil
+	return &cloned
+}
+
+// MutateWithType removes the else branch like Mutate, but keeps local variables and imported
+// packages only used by it in use with a blank assignment in an empty else block.
+func (m ElseBodyRemoval) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	stmt := node.(*ast.IfStmt)
+	cloned := *stmt
+	cloned.Else = nil
+	if keep := typeutil.BlankAssign(typeutil.Unused(typeInfo, stmt.Else)); keep != nil {
+		cloned.Else = &ast.BlockStmt{Lbrace: stmt.Else.Pos(), List: []ast.Stmt{keep}, Rbrace: stmt.Else.End() - 1}
+	}
+	return &cloned
+}
@@ -0,0 +1,57 @@
+package branch
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestElseBodyRemovalName(t *testing.T) {
+	mut := ElseBodyRemoval{}
+
+	if got, want := mut.Name(), "Branch_ELSE_EMPTY"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestElseBodyRemovalCanMutate(t *testing.T) {
+	mut := ElseBodyRemoval{}
+	stmts, _ := testutil.Nodes[*ast.IfStmt](t, source)
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "else if is mutable", node: stmts[0], want: true},
+		{name: "if without else is not mutable", node: stmts[1], want: false},
+		{name: "empty else is not mutable", node: &ast.IfStmt{Body: &ast.BlockStmt{}, Else: &ast.BlockStmt{}}, want: false},
+		{name: "terminating if-else is not mutable", node: stmts[2], want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestElseBodyRemovalMutate(t *testing.T) {
+	mut := ElseBodyRemoval{}
+	stmts, info := testutil.Nodes[*ast.IfStmt](t, source)
+
+	if mutated := mut.Mutate(stmts[0]).(*ast.IfStmt); mutated.Else != nil {
+		t.Fatalf("Mutate() kept the else branch")
+	}
+	if stmts[0].Else == nil {
+		t.Fatalf("Mutate() modified the original statement")
+	}
+	if mutated := mut.MutateWithType(stmts[0], info).(*ast.IfStmt); mutated.Else != nil {
+		t.Fatalf("MutateWithType() kept the else branch: %s", printNode(t, mutated.Else))
+	}
+}
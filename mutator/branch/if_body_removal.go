@@ -0,0 +1,55 @@
+package branch
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// IfBodyRemoval empties the body of if statements.
+// if cond { ... } -> if cond {}
+// If-else statements whose branches both terminate are left alone, since the function
+// could otherwise end without a return.
+type IfBodyRemoval struct{}
+
+func (m IfBodyRemoval) Name() string {
+	return "Branch_IF_EMPTY"
+}
+
+func (m IfBodyRemoval) CanMutate(node ast.Node) bool {
+	stmt, ok := node.(*ast.IfStmt)
+	return ok && len(stmt.Body.List) > 0 && !astutil.Terminates(stmt)
+}
+
+// CanMutateWithType additionally skips bodies that are the only use of a package a blank
+// assignment can't keep in use, as in var v pkg.T.
+func (m IfBodyRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && typeutil.Keepable(typeInfo, node.(*ast.IfStmt).Body)
+}
+
+func (m IfBodyRemoval) Mutate(node ast.Node) ast.Node {
+	stmt := node.(*ast.IfStmt)
+	cloned := *stmt
+	cloned.Body = emptyBlock(stmt.Body, nil)
+	return &cloned
+}
+
+// MutateWithType empties the body like Mutate, but keeps local variables and imported packages
+// only used by it in use with a blank assignment, so the mutant still compiles.
+func (m IfBodyRemoval) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	stmt := node.(*ast.IfStmt)
+	cloned := *stmt
+	cloned.Body = emptyBlock(stmt.Body, typeutil.BlankAssign(typeutil.Unused(typeInfo, stmt.Body)))
+	return &cloned
+}
+
+// emptyBlock returns a block at the position of block holding only keep, if any.
+func emptyBlock(block *ast.BlockStmt, keep ast.Stmt) *ast.BlockStmt {
+	empty := &ast.BlockStmt{Lbrace: block.Lbrace, Rbrace: block.Rbrace}
+	if keep != nil {
+		empty.List = []ast.Stmt{keep}
+	}
+	return empty
+}
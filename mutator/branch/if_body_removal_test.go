@@ -0,0 +1,110 @@
+package branch
+
+import (
+	"bytes"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+import "fmt"
+
+func f(x int, v any, c chan int) int {
+	n := x
+	if x > 0 {
+		fmt.Println(n)
+	} else if x < 0 {
+		x = -x
+	}
+	switch x {
+	case 1:
+		x++
+		fallthrough
+	case 2:
+		x--
+	default:
+		return 0
+	}
+	switch v := v.(type) {
+	case int:
+		return v
+	case string:
+		return len(v)
+	}
+	select {
+	case <-c:
+	default:
+	}
+	if x > 1 {
+		return 1
+	} else {
+		return 2
+	}
+}
+`
+
+func printNode(t *testing.T, node ast.Node) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), node); err != nil {
+		t.Fatalf("failed to print: %v", err)
+	}
+	return buf.String()
+}
+
+func TestIfBodyRemovalName(t *testing.T) {
+	mut := IfBodyRemoval{}
+
+	if got, want := mut.Name(), "Branch_IF_EMPTY"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestIfBodyRemovalCanMutate(t *testing.T) {
+	mut := IfBodyRemoval{}
+	stmts, _ := testutil.Nodes[*ast.IfStmt](t, source)
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "if statement is mutable", node: stmts[0], want: true},
+		{name: "empty body is not mutable", node: &ast.IfStmt{Body: &ast.BlockStmt{}}, want: false},
+		{name: "terminating if-else is not mutable", node: stmts[2], want: false},
+		{name: "block is not mutable", node: &ast.BlockStmt{}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIfBodyRemovalMutate(t *testing.T) {
+	mut := IfBodyRemoval{}
+	stmts, info := testutil.Nodes[*ast.IfStmt](t, source)
+
+	mutated := mut.Mutate(stmts[0]).(*ast.IfStmt)
+	if len(mutated.Body.List) != 0 {
+		t.Fatalf("Mutate() body has %d statements, want 0", len(mutated.Body.List))
+	}
+	if len(stmts[0].Body.List) == 0 {
+		t.Fatalf("Mutate() modified the original body")
+	}
+
+	mutated = mut.MutateWithType(stmts[0], info).(*ast.IfStmt)
+	if got, want := printNode(t, mutated.Body), "{\n\t_, _ = fmt.Println, n\n}"; got != want {
+		t.Fatalf("MutateWithType() body = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+package branch
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// IfElseSwap swaps the bodies of if and else branches.
+// if cond { a } else { b } -> if cond { b } else { a }
+// The swapped blocks keep their positions, so the body follows the else branch in source order
+// and the printer would place the comments within them in the wrong branch; with type information
+// those comments are dropped.
+type IfElseSwap struct{}
+
+func (m IfElseSwap) Name() string {
+	return "Branch_IF_ELSE_SWAP"
+}
+
+func (m IfElseSwap) CanMutate(node ast.Node) bool {
+	stmt, ok := node.(*ast.IfStmt)
+	if !ok {
+		return false
+	}
+	_, ok = stmt.Else.(*ast.BlockStmt)
+	return ok
+}
+
+func (m IfElseSwap) Mutate(node ast.Node) ast.Node {
+	stmt := node.(*ast.IfStmt)
+	cloned := *stmt
+	cloned.Body = stmt.Else.(*ast.BlockStmt)
+	cloned.Else = stmt.Body
+	return &cloned
+}
+
+// MutateWithType swaps the branches like Mutate and removes the comments within them from
+// the file containing them.
+func (m IfElseSwap) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	stmt := node.(*ast.IfStmt)
+	if file := typeutil.File(typeInfo, stmt.Pos()); file != nil {
+		comments := file.Comments[:0]
+		for _, group := range file.Comments {
+			if !within(group, stmt.Body) && !within(group, stmt.Else) {
+				comments = append(comments, group)
+			}
+		}
+		file.Comments = comments
+	}
+	return m.Mutate(node)
+}
+
+func within(group *ast.CommentGroup, n ast.Node) bool {
+	return n.Pos() <= group.Pos() && group.End() <= n.End()
+}
@@ -0,0 +1,98 @@
+package branch
+
+import (
+	"bytes"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestIfElseSwapName(t *testing.T) {
+	mut := IfElseSwap{}
+
+	if got, want := mut.Name(), "Branch_IF_ELSE_SWAP"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestIfElseSwapCanMutate(t *testing.T) {
+	mut := IfElseSwap{}
+	stmts, _ := testutil.Nodes[*ast.IfStmt](t, source)
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "if-else is mutable", node: stmts[2], want: true},
+		{name: "else if is not mutable", node: stmts[0], want: false},
+		{name: "if without else is not mutable", node: stmts[1], want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIfElseSwapMutate(t *testing.T) {
+	mut := IfElseSwap{}
+	stmts, _ := testutil.Nodes[*ast.IfStmt](t, source)
+
+	original := stmts[2]
+	mutated := mut.Mutate(original).(*ast.IfStmt)
+
+	if mutated.Body != original.Else || mutated.Else != original.Body {
+		t.Fatalf("Mutate() did not swap the branches")
+	}
+}
+
+func TestIfElseSwapMutateWithTypeDropsBranchComments(t *testing.T) {
+	const src = `package sample
+
+func f(x int) int {
+	// check the sign
+	if x > 0 {
+		// positive
+		x++
+	} else {
+		// other
+		x--
+	}
+	// done
+	return x
+}
+`
+	fset := token.NewFileSet()
+	file, info := testutil.Check(t, fset, src)
+	stmt := file.Decls[0].(*ast.FuncDecl).Body.List[0]
+
+	if !astutil.Replace(file, stmt, IfElseSwap{}.MutateWithType(stmt, info)) {
+		t.Fatalf("failed to replace the if statement")
+	}
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, file); err != nil {
+		t.Fatalf("failed to print: %v", err)
+	}
+
+	got := buf.String()
+	if strings.Contains(got, "positive") || strings.Contains(got, "other") {
+		t.Fatalf("MutateWithType() printed\n%s\nwant the branch comments dropped", got)
+	}
+	if !strings.Contains(got, "// check the sign") || !strings.Contains(got, "// done") {
+		t.Fatalf("MutateWithType() printed\n%s\nwant the comments around the if statement kept", got)
+	}
+	if strings.Index(got, "x--") > strings.Index(got, "x++") {
+		t.Fatalf("MutateWithType() printed\n%s\nwant the branches swapped", got)
+	}
+}
@@ -0,0 +1,46 @@
+package collection
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// AppendRemoval drops the appended elements, leaving the slice unchanged.
+// append(s, v) -> s
+type AppendRemoval struct{}
+
+func (m AppendRemoval) Name() string {
+	return "Collection_APPEND_REMOVE"
+}
+
+func (m AppendRemoval) CanMutate(node ast.Node) bool {
+	call := builtinCall(node, "append")
+	return call != nil && len(call.Args) > 1
+}
+
+// CanMutateWithType additionally checks that append is the builtin and that the appended
+// elements aren't the only use of a variable or import.
+func (m AppendRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	call := node.(*ast.CallExpr)
+	if !isBuiltin(typeInfo, call) {
+		return false
+	}
+	// the first argument may be an untyped nil, e.g. append([]byte(nil), b...)
+	if tv := typeInfo.Types[call.Args[0]]; tv.IsNil() || !types.Identical(tv.Type, typeInfo.Types[call].Type) {
+		return false
+	}
+	var appended []ast.Node
+	for _, arg := range call.Args[1:] {
+		appended = append(appended, arg)
+	}
+	return len(typeutil.Unused(typeInfo, appended...)) == 0
+}
+
+func (m AppendRemoval) Mutate(node ast.Node) ast.Node {
+	return node.(*ast.CallExpr).Args[0]
+}
@@ -0,0 +1,49 @@
+package collection
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestAppendRemovalName(t *testing.T) {
+	mut := AppendRemoval{}
+
+	if got, want := mut.Name(), "Collection_APPEND_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestAppendRemovalCanMutateWithType(t *testing.T) {
+	mut := AppendRemoval{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "append with elements is mutable", text: "append(s, a)", want: true},
+		{name: "append without elements is not mutable", text: "append(s)", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAppendRemovalMutate(t *testing.T) {
+	mut := AppendRemoval{}
+	node, _ := testutil.Find[*ast.CallExpr](t, source, "append(s, a)")
+
+	if got, want := types.ExprString(mut.Mutate(node).(ast.Expr)), "s"; got != want {
+		t.Fatalf("Mutate() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,38 @@
+package collection
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// CopyRemoval removes copies between slices whose count isn't used.
+// copy(dst, src) -> (removed)
+type CopyRemoval struct{}
+
+func (m CopyRemoval) Name() string {
+	return "Collection_COPY_REMOVE"
+}
+
+func (m CopyRemoval) CanMutate(node ast.Node) bool {
+	return stmtBuiltinCall(node, "copy") != nil
+}
+
+// CanMutateWithType additionally checks that copy is the builtin, not a shadowing declaration.
+func (m CopyRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	call := stmtBuiltinCall(node, "copy")
+	return call != nil && isBuiltin(typeInfo, call) && typeutil.Keepable(typeInfo, node)
+}
+
+func (m CopyRemoval) Mutate(node ast.Node) ast.Node {
+	return &ast.EmptyStmt{Semicolon: node.Pos(), Implicit: true}
+}
+
+// MutateWithType removes the statement like Mutate, keeping variables only used by it in use.
+func (m CopyRemoval) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	if assign := typeutil.BlankAssign(typeutil.Unused(typeInfo, node)); assign != nil {
+		return assign
+	}
+	return m.Mutate(node)
+}
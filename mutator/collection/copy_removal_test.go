@@ -0,0 +1,49 @@
+package collection
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestCopyRemovalName(t *testing.T) {
+	mut := CopyRemoval{}
+
+	if got, want := mut.Name(), "Collection_COPY_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestCopyRemovalCanMutate(t *testing.T) {
+	mut := CopyRemoval{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "copy statement is mutable", node: &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("copy")}}, want: true},
+		{name: "used copy count is not mutable", node: &ast.AssignStmt{Rhs: []ast.Expr{&ast.CallExpr{Fun: ast.NewIdent("copy")}}}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCopyRemovalMutateWithType(t *testing.T) {
+	mut := CopyRemoval{}
+	node, info := testutil.Find[*ast.ExprStmt](t, source, "copy(s, s[1:])")
+
+	if !mut.CanMutateWithType(node, info) {
+		t.Fatalf("CanMutateWithType() = false, want true")
+	}
+	if _, ok := mut.MutateWithType(node, info).(*ast.EmptyStmt); !ok {
+		t.Fatalf("MutateWithType() didn't return *ast.EmptyStmt")
+	}
+}
@@ -0,0 +1,38 @@
+package collection
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// DeleteRemoval removes deletions from maps.
+// delete(m, k) -> (removed)
+type DeleteRemoval struct{}
+
+func (m DeleteRemoval) Name() string {
+	return "Collection_DELETE_REMOVE"
+}
+
+func (m DeleteRemoval) CanMutate(node ast.Node) bool {
+	return stmtBuiltinCall(node, "delete") != nil
+}
+
+// CanMutateWithType additionally checks that delete is the builtin, not a shadowing declaration.
+func (m DeleteRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	call := stmtBuiltinCall(node, "delete")
+	return call != nil && isBuiltin(typeInfo, call) && typeutil.Keepable(typeInfo, node)
+}
+
+func (m DeleteRemoval) Mutate(node ast.Node) ast.Node {
+	return &ast.EmptyStmt{Semicolon: node.Pos(), Implicit: true}
+}
+
+// MutateWithType removes the statement like Mutate, keeping variables only used by it in use.
+func (m DeleteRemoval) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	if assign := typeutil.BlankAssign(typeutil.Unused(typeInfo, node)); assign != nil {
+		return assign
+	}
+	return m.Mutate(node)
+}
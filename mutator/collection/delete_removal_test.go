@@ -0,0 +1,50 @@
+package collection
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestDeleteRemovalName(t *testing.T) {
+	mut := DeleteRemoval{}
+
+	if got, want := mut.Name(), "Collection_DELETE_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestDeleteRemovalCanMutate(t *testing.T) {
+	mut := DeleteRemoval{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "delete statement is mutable", node: &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("delete")}}, want: true},
+		{name: "other call is not mutable", node: &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("remove")}}, want: false},
+		{name: "deferred delete is not mutable", node: &ast.DeferStmt{Call: &ast.CallExpr{Fun: ast.NewIdent("delete")}}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDeleteRemovalMutateWithType(t *testing.T) {
+	mut := DeleteRemoval{}
+	node, info := testutil.Find[*ast.ExprStmt](t, source, "delete(m, k)")
+
+	if !mut.CanMutateWithType(node, info) {
+		t.Fatalf("CanMutateWithType() = false, want true")
+	}
+	if _, ok := mut.MutateWithType(node, info).(*ast.EmptyStmt); !ok {
+		t.Fatalf("MutateWithType() didn't return *ast.EmptyStmt")
+	}
+}
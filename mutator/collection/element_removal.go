@@ -0,0 +1,56 @@
+package collection
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ElementRemoval removes an element from slice and map literals.
+// []int{1, 2, 3} -> []int{1, 3}
+// map[string]int{"a": 1, "b": 2} -> map[string]int{"b": 2}
+type ElementRemoval struct{}
+
+func (m ElementRemoval) Name() string {
+	return "Collection_ELEMENT_REMOVE"
+}
+
+// CanMutate reports false: whether an element belongs to a slice or map literal is only known
+// from its enclosing literal's type.
+func (m ElementRemoval) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType accepts elements of slice and map literals, but not of arrays, whose length
+// may depend on the number of elements, nor elements that are the only use of a variable.
+func (m ElementRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	elt, ok := node.(ast.Expr)
+	if !ok || typeInfo == nil {
+		return false
+	}
+	file := typeutil.File(typeInfo, elt.Pos())
+	if file == nil {
+		return false
+	}
+	lit, ok := astutil.Parent(file, elt).(*ast.CompositeLit)
+	if !ok || lit.Type == elt {
+		return false
+	}
+	tv, ok := typeInfo.Types[lit]
+	if !ok {
+		return false
+	}
+	switch tv.Type.Underlying().(type) {
+	case *types.Slice, *types.Map:
+	default:
+		return false
+	}
+	return len(typeutil.Unused(typeInfo, elt)) == 0
+}
+
+// Mutate returns nil, which removes the element from the literal.
+func (m ElementRemoval) Mutate(node ast.Node) ast.Node {
+	return nil
+}
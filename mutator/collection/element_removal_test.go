@@ -0,0 +1,49 @@
+package collection
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestElementRemovalName(t *testing.T) {
+	mut := ElementRemoval{}
+
+	if got, want := mut.Name(), "Collection_ELEMENT_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestElementRemovalCanMutateWithType(t *testing.T) {
+	mut := ElementRemoval{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "slice element is mutable", text: "1, 2, size", want: true},
+		{name: "map entry is mutable", text: `"b": 2`, want: true},
+		{name: "only use of a variable is not mutable", text: "size}", want: false},
+		{name: "only use of a package through a type is not mutable", text: "strings.Builder{}", want: false},
+		{name: "array element is not mutable", text: "1, 2}", want: false},
+		{name: "literal type is not mutable", text: "[]int{", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[ast.Expr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestElementRemovalMutate(t *testing.T) {
+	if got := (ElementRemoval{}).Mutate(ast.NewIdent("x")); got != nil {
+		t.Fatalf("Mutate() = %#v, want nil", got)
+	}
+}
@@ -0,0 +1,70 @@
+package collection
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// LenDecrement subtracts one from lengths, as in an off-by-one error.
+// len(s) -> len(s) - 1
+type LenDecrement struct{}
+
+func (m LenDecrement) Name() string {
+	return "Collection_LEN_DECREMENT"
+}
+
+func (m LenDecrement) CanMutate(node ast.Node) bool {
+	return builtinCall(node, "len") != nil
+}
+
+// CanMutateWithType additionally checks that len is the builtin and its result isn't a constant,
+// such as the length of an array, which may be used where a negative value doesn't compile.
+func (m LenDecrement) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	call := builtinCall(node, "len")
+	return call != nil && isBuiltin(typeInfo, call) && typeInfo.Types[call].Value == nil
+}
+
+func (m LenDecrement) Mutate(node ast.Node) ast.Node {
+	return &ast.BinaryExpr{
+		X:  node.(ast.Expr),
+		Op: token.SUB,
+		Y:  &ast.BasicLit{Kind: token.INT, Value: "1"},
+	}
+}
+
+// builtinCall returns node if it is a call of the function named name, which is the builtin
+// unless it is shadowed.
+func builtinCall(node ast.Node, name string) *ast.CallExpr {
+	call, ok := node.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	ident, ok := ast.Unparen(call.Fun).(*ast.Ident)
+	if !ok || ident.Name != name {
+		return nil
+	}
+	return call
+}
+
+// isBuiltin reports whether call calls a builtin function rather than a shadowing declaration.
+func isBuiltin(typeInfo *types.Info, call *ast.CallExpr) bool {
+	if typeInfo == nil {
+		return false
+	}
+	ident, ok := ast.Unparen(call.Fun).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	_, ok = typeInfo.Uses[ident].(*types.Builtin)
+	return ok
+}
+
+// stmtBuiltinCall returns the call of the named builtin made by an expression statement.
+func stmtBuiltinCall(node ast.Node, name string) *ast.CallExpr {
+	stmt, ok := node.(*ast.ExprStmt)
+	if !ok {
+		return nil
+	}
+	return builtinCall(stmt.X, name)
+}
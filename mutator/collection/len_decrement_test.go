@@ -0,0 +1,110 @@
+package collection
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+import "strings"
+
+var table [4]int
+
+func run(s []int, m map[string]int, k string, a, b int) int {
+	n := len(s)
+	size := len(table)
+	_ = 10 / len(s)
+	_ = len(s) > 0
+	s = append(s, a)
+	s = append(s)
+	_ = s[1:n]
+	_ = s[a:b]
+	_ = s[:2]
+	_ = s[2:2]
+	_ = s[:0]
+	_ = table[4:b]
+	_ = "text"[1:3]
+	delete(m, k)
+	copy(s, s[1:])
+	lo, hi := min(a, b), max(a, b, n)
+	_ = min(1, 2)
+	nums := []int{1, 2, size}
+	names := map[string]int{"a": 1, "b": 2}
+	arr := [...]int{1, 2}
+	builders := []any{1, strings.Builder{}}
+	_, _, _, _, _, _ = lo, hi, nums, names, arr, builders
+	return n
+}
+
+func shadow(len func([]int) int) int {
+	return len(nil)
+}
+`
+
+func TestLenDecrementName(t *testing.T) {
+	mut := LenDecrement{}
+
+	if got, want := mut.Name(), "Collection_LEN_DECREMENT"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestLenDecrementCanMutate(t *testing.T) {
+	mut := LenDecrement{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "len call is mutable", node: &ast.CallExpr{Fun: ast.NewIdent("len"), Args: []ast.Expr{ast.NewIdent("s")}}, want: true},
+		{name: "cap call is not mutable", node: &ast.CallExpr{Fun: ast.NewIdent("cap"), Args: []ast.Expr{ast.NewIdent("s")}}, want: false},
+		{name: "method named len is not mutable", node: &ast.CallExpr{Fun: &ast.SelectorExpr{X: ast.NewIdent("s"), Sel: ast.NewIdent("len")}}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLenDecrementCanMutateWithType(t *testing.T) {
+	mut := LenDecrement{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "slice length is mutable", text: "len(s)\n\tsize", want: true},
+		{name: "divisor is mutable", text: "len(s)\n\t_ = len", want: true},
+		{name: "array length is not mutable", text: "len(table)", want: false},
+		{name: "shadowed len is not mutable", text: "len(nil)", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLenDecrementMutate(t *testing.T) {
+	mut := LenDecrement{}
+	node, _ := testutil.Find[*ast.CallExpr](t, source, "len(s)")
+
+	if got, want := types.ExprString(mut.Mutate(node).(ast.Expr)), "len(s) - 1"; got != want {
+		t.Fatalf("Mutate() = %s, want %s", got, want)
+	}
+}
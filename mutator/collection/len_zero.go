@@ -0,0 +1,40 @@
+package collection
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// LenZero replaces lengths with zero, as if the collection were empty.
+// len(s) -> 0
+type LenZero struct{}
+
+func (m LenZero) Name() string {
+	return "Collection_LEN_ZERO"
+}
+
+func (m LenZero) CanMutate(node ast.Node) bool {
+	return builtinCall(node, "len") != nil
+}
+
+// CanMutateWithType additionally checks that len is the builtin and its result isn't a constant.
+// Lengths are skipped where a constant zero doesn't compile, e.g. as a divisor, and where the
+// argument is the only use of a variable.
+func (m LenZero) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	call := builtinCall(node, "len")
+	if call == nil || !isBuiltin(typeInfo, call) || typeInfo.Types[call].Value != nil {
+		return false
+	}
+	if typeutil.ConstantContext(typeInfo, call) || typeutil.DuplicatesConstant(typeInfo, call, constant.MakeInt64(0)) {
+		return false
+	}
+	return len(typeutil.Unused(typeInfo, call)) == 0
+}
+
+func (m LenZero) Mutate(node ast.Node) ast.Node {
+	return &ast.BasicLit{ValuePos: node.Pos(), Kind: token.INT, Value: "0"}
+}
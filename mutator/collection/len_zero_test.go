@@ -0,0 +1,51 @@
+package collection
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestLenZeroName(t *testing.T) {
+	mut := LenZero{}
+
+	if got, want := mut.Name(), "Collection_LEN_ZERO"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestLenZeroCanMutateWithType(t *testing.T) {
+	mut := LenZero{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "slice length is mutable", text: "len(s)\n\tsize", want: true},
+		{name: "compared length is mutable", text: "len(s) > 0", want: true},
+		{name: "divisor is not mutable", text: "len(s)\n\t_ = len", want: false},
+		{name: "array length is not mutable", text: "len(table)", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLenZeroMutate(t *testing.T) {
+	mut := LenZero{}
+	node, _ := testutil.Find[*ast.CallExpr](t, source, "len(s)")
+
+	if got, want := types.ExprString(mut.Mutate(node).(ast.Expr)), "0"; got != want {
+		t.Fatalf("Mutate() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package collection
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// MinMaxSwap swaps the min and max builtins.
+// min(a, b) -> max(a, b)
+// max(a, b) -> min(a, b)
+type MinMaxSwap struct{}
+
+func (m MinMaxSwap) Name() string {
+	return "Collection_MIN_MAX_SWAP"
+}
+
+func (m MinMaxSwap) CanMutate(node ast.Node) bool {
+	return minOrMax(node) != nil
+}
+
+// CanMutateWithType additionally checks that the call is of the builtin and not constant, since
+// constant results may be used where the other bound doesn't compile, such as an array length.
+func (m MinMaxSwap) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	call := minOrMax(node)
+	return call != nil && isBuiltin(typeInfo, call) && typeInfo.Types[call].Value == nil
+}
+
+func (m MinMaxSwap) Mutate(node ast.Node) ast.Node {
+	call := node.(*ast.CallExpr)
+	fun := ast.Unparen(call.Fun).(*ast.Ident)
+	name := "min"
+	if fun.Name == "min" {
+		name = "max"
+	}
+	mutated := *call
+	mutated.Fun = &ast.Ident{NamePos: fun.NamePos, Name: name}
+	return &mutated
+}
+
+// minOrMax returns node if it is a call of min or max with more than one argument.
+func minOrMax(node ast.Node) *ast.CallExpr {
+	call := builtinCall(node, "min")
+	if call == nil {
+		call = builtinCall(node, "max")
+	}
+	if call == nil || len(call.Args) < 2 {
+		return nil
+	}
+	return call
+}
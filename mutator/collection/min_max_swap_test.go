@@ -0,0 +1,66 @@
+package collection
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestMinMaxSwapName(t *testing.T) {
+	mut := MinMaxSwap{}
+
+	if got, want := mut.Name(), "Collection_MIN_MAX_SWAP"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestMinMaxSwapCanMutateWithType(t *testing.T) {
+	mut := MinMaxSwap{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "min is mutable", text: "min(a, b)", want: true},
+		{name: "max is mutable", text: "max(a, b, n)", want: true},
+		{name: "constant min is not mutable", text: "min(1, 2)", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMinMaxSwapMutate(t *testing.T) {
+	mut := MinMaxSwap{}
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "min(a, b)", want: "max(a, b)"},
+		{text: "max(a, b, n)", want: "min(a, b, n)"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.text, func(t *testing.T) {
+			node, _ := testutil.Find[*ast.CallExpr](t, source, tc.text)
+
+			if got := types.ExprString(mut.Mutate(node).(ast.Expr)); got != tc.want {
+				t.Fatalf("Mutate() = %s, want %s", got, tc.want)
+			}
+			if got := types.ExprString(node); got != tc.text {
+				t.Fatalf("Mutate() modified the original call to %s", got)
+			}
+		})
+	}
+}
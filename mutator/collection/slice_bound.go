@@ -0,0 +1,146 @@
+package collection
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+)
+
+// SliceLow moves the low bound of slice expressions up by one.
+// s[a:b] -> s[a+1:b]
+// s[:b] -> s[1:b]
+type SliceLow struct{}
+
+func (m SliceLow) Name() string {
+	return "Collection_SLICE_LOW"
+}
+
+func (m SliceLow) CanMutate(node ast.Node) bool {
+	slice, ok := node.(*ast.SliceExpr)
+	return ok && slice.High != nil
+}
+
+// CanMutateWithType additionally checks that constant bounds stay in order and that the sliced
+// value isn't a constant string.
+func (m SliceLow) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	slice := node.(*ast.SliceExpr)
+	low := constant.MakeInt64(0)
+	if slice.Low != nil {
+		low = typeInfo.Types[slice.Low].Value
+	}
+	return validBounds(typeInfo, slice, shift(low, 1), typeInfo.Types[slice.High].Value)
+}
+
+func (m SliceLow) Mutate(node ast.Node) ast.Node {
+	slice := node.(*ast.SliceExpr)
+	mutated := *slice
+	if slice.Low == nil {
+		mutated.Low = &ast.BasicLit{ValuePos: slice.Lbrack + 1, Kind: token.INT, Value: "1"}
+	} else {
+		mutated.Low = offset(slice.Low, 1)
+	}
+	return &mutated
+}
+
+// SliceHigh moves the high bound of slice expressions down by one.
+// s[a:b] -> s[a:b-1]
+type SliceHigh struct{}
+
+func (m SliceHigh) Name() string {
+	return "Collection_SLICE_HIGH"
+}
+
+func (m SliceHigh) CanMutate(node ast.Node) bool {
+	slice, ok := node.(*ast.SliceExpr)
+	return ok && slice.High != nil
+}
+
+// CanMutateWithType additionally checks that constant bounds stay in order and that the sliced
+// value isn't a constant string.
+func (m SliceHigh) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	slice := node.(*ast.SliceExpr)
+	low := constant.MakeInt64(0)
+	if slice.Low != nil {
+		low = typeInfo.Types[slice.Low].Value
+	}
+	return validBounds(typeInfo, slice, low, shift(typeInfo.Types[slice.High].Value, -1))
+}
+
+func (m SliceHigh) Mutate(node ast.Node) ast.Node {
+	slice := node.(*ast.SliceExpr)
+	mutated := *slice
+	mutated.High = offset(slice.High, -1)
+	return &mutated
+}
+
+// arrayLen returns the length of t if it is an array or a pointer to an array.
+func arrayLen(t types.Type) (int64, bool) {
+	if t == nil {
+		return 0, false
+	}
+	if ptr, ok := t.Underlying().(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	array, ok := t.Underlying().(*types.Array)
+	if !ok {
+		return 0, false
+	}
+	return array.Len(), true
+}
+
+// offset returns expr plus delta, computing the result for integer literals.
+func offset(expr ast.Expr, delta int64) ast.Expr {
+	if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		if v, err := strconv.ParseInt(lit.Value, 0, 64); err == nil && v+delta >= 0 {
+			return &ast.BasicLit{ValuePos: lit.ValuePos, Kind: token.INT, Value: strconv.FormatInt(v+delta, 10)}
+		}
+	}
+	op := token.ADD
+	if delta < 0 {
+		op, delta = token.SUB, -delta
+	}
+	return &ast.BinaryExpr{X: expr, Op: op, Y: &ast.BasicLit{Kind: token.INT, Value: strconv.FormatInt(delta, 10)}}
+}
+
+// shift returns the constant v plus delta, or nil when v isn't constant.
+func shift(v constant.Value, delta int64) constant.Value {
+	if v == nil {
+		return nil
+	}
+	return constant.BinaryOp(v, token.ADD, constant.MakeInt64(delta))
+}
+
+// validBounds reports whether the mutated low and high bounds of slice, nil where they aren't
+// constant, still compile: constant bounds must be non-negative, in order, also with respect
+// to a constant max bound, and within the length of a sliced array. Constant strings are skipped.
+func validBounds(typeInfo *types.Info, slice *ast.SliceExpr, low, high constant.Value) bool {
+	if typeInfo.Types[slice.X].Value != nil {
+		return false
+	}
+	if length, ok := arrayLen(typeInfo.Types[slice.X].Type); ok && low != nil && constant.Compare(low, token.GTR, constant.MakeInt64(length)) {
+		return false
+	}
+	bounds := []constant.Value{low, high}
+	if slice.Max != nil {
+		bounds = append(bounds, typeInfo.Types[slice.Max].Value)
+	}
+	var prev constant.Value
+	for _, v := range bounds {
+		if v == nil {
+			continue
+		}
+		if constant.Sign(v) < 0 || (prev != nil && constant.Compare(prev, token.GTR, v)) {
+			return false
+		}
+		prev = v
+	}
+	return true
+}
@@ -0,0 +1,122 @@
+package collection
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestSliceLowName(t *testing.T) {
+	mut := SliceLow{}
+
+	if got, want := mut.Name(), "Collection_SLICE_LOW"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestSliceLowCanMutateWithType(t *testing.T) {
+	mut := SliceLow{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "variable bounds are mutable", text: "s[a:b]", want: true},
+		{name: "missing low bound is mutable", text: "s[:2]", want: true},
+		{name: "equal constant bounds are not mutable", text: "s[2:2]", want: false},
+		{name: "missing high bound is not mutable", text: "s[1:])", want: false},
+		{name: "array end is not mutable", text: "table[4:b]", want: false},
+		{name: "constant string is not mutable", text: `"text"[1:3]`, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.SliceExpr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSliceLowMutate(t *testing.T) {
+	mut := SliceLow{}
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "s[a:b]", want: "s[a + 1:b]"},
+		{text: "s[1:n]", want: "s[2:n]"},
+		{text: "s[:2]", want: "s[1:2]"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.text, func(t *testing.T) {
+			node, _ := testutil.Find[*ast.SliceExpr](t, source, tc.text)
+
+			if got := types.ExprString(mut.Mutate(node).(ast.Expr)); got != tc.want {
+				t.Fatalf("Mutate() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSliceHighName(t *testing.T) {
+	mut := SliceHigh{}
+
+	if got, want := mut.Name(), "Collection_SLICE_HIGH"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestSliceHighCanMutateWithType(t *testing.T) {
+	mut := SliceHigh{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "variable bounds are mutable", text: "s[a:b]", want: true},
+		{name: "constant high bound is mutable", text: "s[:2]", want: true},
+		{name: "equal constant bounds are not mutable", text: "s[2:2]", want: false},
+		{name: "zero high bound is not mutable", text: "s[:0]", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.SliceExpr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSliceHighMutate(t *testing.T) {
+	mut := SliceHigh{}
+
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "s[a:b]", want: "s[a:b - 1]"},
+		{text: "s[:2]", want: "s[:1]"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.text, func(t *testing.T) {
+			node, _ := testutil.Find[*ast.SliceExpr](t, source, tc.text)
+
+			if got := types.ExprString(mut.Mutate(node).(ast.Expr)); got != tc.want {
+				t.Fatalf("Mutate() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// CloseRemoval removes channel closes.
+// close(ch) -> (removed)
+// defer close(ch) -> (removed)
+type CloseRemoval struct{}
+
+func (m CloseRemoval) Name() string {
+	return "Concurrency_CLOSE_REMOVE"
+}
+
+func (m CloseRemoval) CanMutate(node ast.Node) bool {
+	stmt, ok := node.(ast.Stmt)
+	if !ok {
+		return false
+	}
+	call := stmtCall(stmt)
+	if call == nil {
+		return false
+	}
+	ident, ok := call.Fun.(*ast.Ident)
+	return ok && ident.Name == "close"
+}
+
+// CanMutateWithType additionally checks that close is the builtin, not a shadowing declaration.
+func (m CloseRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	ident := stmtCall(node.(ast.Stmt)).Fun.(*ast.Ident)
+	_, ok := typeInfo.Uses[ident].(*types.Builtin)
+	return ok
+}
+
+func (m CloseRemoval) Mutate(node ast.Node) ast.Node {
+	return &ast.EmptyStmt{Semicolon: node.Pos(), Implicit: true}
+}
+
+// MutateWithType removes the statement like Mutate, keeping variables only used by it in use.
+func (m CloseRemoval) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	return removeStmt(node.(ast.Stmt), typeInfo)
+}
@@ -0,0 +1,70 @@
+package concurrency
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestCloseRemovalName(t *testing.T) {
+	mut := CloseRemoval{}
+
+	if got, want := mut.Name(), "Concurrency_CLOSE_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestCloseRemovalCanMutate(t *testing.T) {
+	mut := CloseRemoval{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "close is mutable", node: &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("close")}}, want: true},
+		{name: "deferred close is mutable", node: &ast.DeferStmt{Call: &ast.CallExpr{Fun: ast.NewIdent("close")}}, want: true},
+		{name: "other call is not mutable", node: &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("f")}}, want: false},
+		{name: "method named close is not mutable", node: &ast.ExprStmt{X: &ast.CallExpr{Fun: &ast.SelectorExpr{X: ast.NewIdent("f"), Sel: ast.NewIdent("close")}}}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCloseRemovalCanMutateWithType(t *testing.T) {
+	mut := CloseRemoval{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "builtin close is mutable", text: "close(ch)", want: true},
+		{name: "shadowed close is not mutable", text: "close(nil)", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[ast.Stmt](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCloseRemovalMutate(t *testing.T) {
+	mut := CloseRemoval{}
+
+	if _, ok := mut.Mutate(&ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("close")}}).(*ast.EmptyStmt); !ok {
+		t.Fatalf("Mutate() didn't return *ast.EmptyStmt")
+	}
+}
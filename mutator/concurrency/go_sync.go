@@ -0,0 +1,25 @@
+package concurrency
+
+import (
+	"go/ast"
+)
+
+// GoSync runs goroutines synchronously by removing the go keyword.
+// go f() -> f()
+// A goroutine that waits on its caller, e.g. sending on an unbuffered channel the caller
+// receives from afterwards, blocks forever when run synchronously; the runner's timeout kills such mutants.
+type GoSync struct{}
+
+func (m GoSync) Name() string {
+	return "Concurrency_GO_SYNC"
+}
+
+func (m GoSync) CanMutate(node ast.Node) bool {
+	_, ok := node.(*ast.GoStmt)
+	return ok
+}
+
+func (m GoSync) Mutate(node ast.Node) ast.Node {
+	stmt := node.(*ast.GoStmt)
+	return &ast.ExprStmt{X: stmt.Call}
+}
@@ -0,0 +1,48 @@
+package concurrency
+
+import (
+	"go/ast"
+	"testing"
+)
+
+func TestGoSyncName(t *testing.T) {
+	mut := GoSync{}
+
+	if got, want := mut.Name(), "Concurrency_GO_SYNC"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestGoSyncCanMutate(t *testing.T) {
+	mut := GoSync{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "go statement is mutable", node: &ast.GoStmt{Call: &ast.CallExpr{Fun: ast.NewIdent("f")}}, want: true},
+		{name: "defer statement is not mutable", node: &ast.DeferStmt{Call: &ast.CallExpr{Fun: ast.NewIdent("f")}}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGoSyncMutate(t *testing.T) {
+	mut := GoSync{}
+	call := &ast.CallExpr{Fun: ast.NewIdent("f")}
+
+	mutated, ok := mut.Mutate(&ast.GoStmt{Call: call}).(*ast.ExprStmt)
+	if !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.ExprStmt", mutated)
+	}
+	if mutated.X != call {
+		t.Fatalf("Mutate() = %#v, want the goroutine's call", mutated.X)
+	}
+}
@@ -0,0 +1,199 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// LockRemoval removes the Lock/Unlock and RLock/RUnlock pairs of sync.Mutex and sync.RWMutex
+// values in a block, leaving the critical sections unprotected.
+// { mu.Lock(); defer mu.Unlock(); n++ } -> { n++ }
+// Only pairs whose unlock is a later statement of the same block are removed. The mutation is
+// reported at the block, since it spans several statements.
+type LockRemoval struct{}
+
+func (m LockRemoval) Name() string {
+	return "Concurrency_LOCK_REMOVE"
+}
+
+// CanMutate reports false: mutexes are recognised by their type.
+func (m LockRemoval) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType checks that node is a block containing a lock pair.
+func (m LockRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	block, ok := node.(*ast.BlockStmt)
+	return ok && len(lockPairs(block, typeInfo)) > 0
+}
+
+// Mutate returns the node unchanged, since mutexes can't be identified without type information.
+func (m LockRemoval) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+// MutateWithType removes the lock pairs, keeping variables only used by them in use.
+func (m LockRemoval) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	block, ok := node.(*ast.BlockStmt)
+	if !ok {
+		return node
+	}
+	pairs := lockPairs(block, typeInfo)
+	if len(pairs) == 0 {
+		return node
+	}
+
+	removed := make(map[int]bool)
+	var removedStmts []ast.Node
+	for _, pair := range pairs {
+		removed[pair.lock], removed[pair.unlock] = true, true
+		removedStmts = append(removedStmts, block.List[pair.lock], block.List[pair.unlock])
+	}
+	mutated := &ast.BlockStmt{Lbrace: block.Lbrace, Rbrace: block.Rbrace}
+	if keep := typeutil.BlankAssign(typeutil.Unused(typeInfo, removedStmts...)); keep != nil {
+		mutated.List = append(mutated.List, keep)
+	}
+	for i, stmt := range block.List {
+		if !removed[i] {
+			mutated.List = append(mutated.List, stmt)
+		}
+	}
+	return mutated
+}
+
+// Race reports true: unprotected critical sections are best detected by the race detector.
+func (m LockRemoval) Race() bool {
+	return true
+}
+
+// lockPair is a lock statement and the later statement of the same block releasing it.
+type lockPair struct {
+	lock, unlock int
+	read         bool // RLock/RUnlock rather than Lock/Unlock
+	rw           bool // the mutex is a sync.RWMutex
+}
+
+// lockPairs returns the lock pairs among the statements of block. A lock is paired with the first
+// following statement calling or deferring the matching unlock on the same receiver, unless an
+// earlier statement unlocks it too, e.g. before an early return.
+func lockPairs(block *ast.BlockStmt, typeInfo *types.Info) []lockPair {
+	if typeInfo == nil {
+		return nil
+	}
+	var pairs []lockPair
+	paired := make(map[int]bool)
+	for i, stmt := range block.List {
+		expr, ok := stmt.(*ast.ExprStmt)
+		if !ok {
+			continue
+		}
+		recv, method, rw := mutexCall(expr.X, typeInfo)
+		if method != "Lock" && method != "RLock" {
+			continue
+		}
+		unlock := "Unlock"
+		if method == "RLock" {
+			unlock = "RUnlock"
+		}
+		for j := i + 1; j < len(block.List); j++ {
+			if !unlocks(block.List[j], recv, unlock, typeInfo) {
+				continue
+			}
+			if isUnlockStmt(block.List[j], recv, unlock, typeInfo) && !paired[j] {
+				pairs = append(pairs, lockPair{lock: i, unlock: j, read: method == "RLock", rw: rw})
+				paired[j] = true
+			}
+			break
+		}
+	}
+	return pairs
+}
+
+// isUnlockStmt reports whether stmt is a call or deferred call of the given unlock method on recv.
+func isUnlockStmt(stmt ast.Stmt, recv, unlock string, typeInfo *types.Info) bool {
+	call := stmtCall(stmt)
+	if call == nil {
+		return false
+	}
+	r, method, _ := mutexCall(call, typeInfo)
+	return method == unlock && r == recv
+}
+
+// unlocks reports whether n contains a call of the given unlock method on recv.
+func unlocks(n ast.Node, recv, unlock string, typeInfo *types.Info) bool {
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		if expr, ok := n.(ast.Expr); ok && !found {
+			if r, method, _ := mutexCall(expr, typeInfo); method == unlock && r == recv {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// mutexCall returns the receiver, as written, and method name of a call of a sync.Mutex or
+// sync.RWMutex method without arguments, and whether the mutex is a sync.RWMutex.
+func mutexCall(expr ast.Expr, typeInfo *types.Info) (recv, method string, rw bool) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) > 0 {
+		return "", "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+	switch syncMethodType(typeInfo, sel) {
+	case "Mutex":
+		return types.ExprString(sel.X), sel.Sel.Name, false
+	case "RWMutex":
+		return types.ExprString(sel.X), sel.Sel.Name, true
+	}
+	return "", "", false
+}
+
+// syncMethodType returns the name of the sync type declaring the method selected by sel,
+// e.g. "Mutex" for mu.Lock, or "" when sel doesn't select a method of a sync type.
+func syncMethodType(typeInfo *types.Info, sel *ast.SelectorExpr) string {
+	fn, ok := typeInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "sync" {
+		return ""
+	}
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() == nil {
+		return ""
+	}
+	recv := sig.Recv().Type()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	named, ok := recv.(*types.Named)
+	if !ok {
+		return ""
+	}
+	return named.Obj().Name()
+}
+
+// stmtCall returns the call made or deferred by stmt, or nil when stmt is neither.
+func stmtCall(stmt ast.Stmt) *ast.CallExpr {
+	switch s := stmt.(type) {
+	case *ast.ExprStmt:
+		call, _ := s.X.(*ast.CallExpr)
+		return call
+	case *ast.DeferStmt:
+		return s.Call
+	}
+	return nil
+}
+
+// removeStmt returns an empty statement replacing stmt, or a blank assignment keeping the
+// variables and packages only used by stmt in use.
+func removeStmt(stmt ast.Stmt, typeInfo *types.Info) ast.Stmt {
+	if keep := typeutil.BlankAssign(typeutil.Unused(typeInfo, stmt)); keep != nil {
+		return keep
+	}
+	return &ast.EmptyStmt{Semicolon: stmt.Pos(), Implicit: true}
+}
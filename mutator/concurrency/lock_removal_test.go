@@ -0,0 +1,185 @@
+package concurrency
+
+import (
+	"bytes"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"strings"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+import "sync"
+
+type counter struct {
+	mu sync.Mutex
+	rw sync.RWMutex
+	n  int
+}
+
+func (c *counter) inc() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.n++
+}
+
+func (c *counter) get() int {
+	c.rw.RLock()
+	n := c.n
+	c.rw.RUnlock()
+	return n
+}
+
+func (c *counter) early(x int) int {
+	c.mu.Lock()
+	if x > 0 {
+		c.mu.Unlock()
+		return x
+	}
+	c.mu.Unlock()
+	return 0
+}
+
+func (c *counter) set(v int) {
+	c.rw.Lock()
+	defer c.rw.Unlock()
+	c.n = v
+}
+
+func run(ch chan int) {
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		close(ch)
+	}()
+	wg.Wait()
+	select {
+	case v := <-ch:
+		_ = v
+	default:
+	}
+	select {
+	default:
+	}
+	var local sync.Mutex
+	local.Lock()
+	local.Unlock()
+}
+
+func poll(ch chan int, done chan struct{}) {
+	for len(ch) > 0 {
+		select {
+		case <-done:
+		default:
+			return
+		}
+	}
+	for {
+		select {
+		case <-done:
+			return
+		default:
+			ch <- 1
+		}
+	}
+}
+
+func shadow() {
+	close := func(chan int) {}
+	close(nil)
+}
+`
+
+// body returns the body of the function whose declaration starts with decl.
+func body(t *testing.T, decl string) (*ast.BlockStmt, *types.Info) {
+	t.Helper()
+	fn, info := testutil.Find[*ast.FuncDecl](t, source, decl)
+	return fn.Body, info
+}
+
+// print formats node as Go source.
+func print(t *testing.T, node ast.Node) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), node); err != nil {
+		t.Fatalf("failed to print: %v", err)
+	}
+	return buf.String()
+}
+
+func TestLockRemovalName(t *testing.T) {
+	mut := LockRemoval{}
+
+	if got, want := mut.Name(), "Concurrency_LOCK_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestLockRemovalCanMutate(t *testing.T) {
+	mut := LockRemoval{}
+
+	if mut.CanMutate(&ast.BlockStmt{}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestLockRemovalCanMutateWithType(t *testing.T) {
+	mut := LockRemoval{}
+
+	tests := []struct {
+		name string
+		decl string
+		want bool
+	}{
+		{name: "deferred unlock is mutable", decl: "func (c *counter) inc", want: true},
+		{name: "read lock is mutable", decl: "func (c *counter) get", want: true},
+		{name: "early unlock is not mutable", decl: "func (c *counter) early", want: false},
+		{name: "block without locks is not mutable", decl: "func shadow", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			block, info := body(t, tc.decl)
+
+			if got := mut.CanMutateWithType(block, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLockRemovalMutateWithType(t *testing.T) {
+	mut := LockRemoval{}
+
+	tests := []struct {
+		name string
+		decl string
+		want string
+	}{
+		{name: "removes deferred pair", decl: "func (c *counter) inc", want: "{\n\tc.n++\n}"},
+		{name: "removes read lock pair", decl: "func (c *counter) get", want: "{\n\tn := c.n\n\treturn n\n}"},
+		{name: "keeps local mutex used", decl: "func run", want: "_ = local"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			block, info := body(t, tc.decl)
+
+			if got := print(t, mut.MutateWithType(block, info)); !strings.Contains(got, tc.want) {
+				t.Fatalf("MutateWithType() printed %q, want it to contain %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLockRemovalRace(t *testing.T) {
+	if !(LockRemoval{}).Race() {
+		t.Fatalf("Race() = false, want true")
+	}
+}
@@ -0,0 +1,85 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// LockSwap swaps read and write locks of sync.RWMutex values in a block, with their unlocks.
+// mu.RLock(); defer mu.RUnlock() -> mu.Lock(); defer mu.Unlock()
+// mu.Lock(); defer mu.Unlock() -> mu.RLock(); defer mu.RUnlock()
+// Taking a read lock where a write lock is needed lets writers race with readers.
+type LockSwap struct{}
+
+func (m LockSwap) Name() string {
+	return "Concurrency_LOCK_SWAP"
+}
+
+// CanMutate reports false: read-write mutexes are recognised by their type.
+func (m LockSwap) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType checks that node is a block containing a lock pair of a sync.RWMutex.
+func (m LockSwap) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	block, ok := node.(*ast.BlockStmt)
+	return ok && len(rwLockPairs(block, typeInfo)) > 0
+}
+
+// Mutate returns the node unchanged, since mutexes can't be identified without type information.
+func (m LockSwap) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+func (m LockSwap) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	block, ok := node.(*ast.BlockStmt)
+	if !ok {
+		return node
+	}
+	pairs := rwLockPairs(block, typeInfo)
+	if len(pairs) == 0 {
+		return node
+	}
+
+	mutated := &ast.BlockStmt{Lbrace: block.Lbrace, List: append([]ast.Stmt(nil), block.List...), Rbrace: block.Rbrace}
+	for _, pair := range pairs {
+		lock, unlock := "RLock", "RUnlock"
+		if pair.read {
+			lock, unlock = "Lock", "Unlock"
+		}
+		mutated.List[pair.lock] = renameCall(block.List[pair.lock], lock)
+		mutated.List[pair.unlock] = renameCall(block.List[pair.unlock], unlock)
+	}
+	return mutated
+}
+
+// Race reports true: writes under a read lock are best detected by the race detector.
+func (m LockSwap) Race() bool {
+	return true
+}
+
+// rwLockPairs returns the lock pairs of sync.RWMutex values among the statements of block.
+func rwLockPairs(block *ast.BlockStmt, typeInfo *types.Info) []lockPair {
+	var pairs []lockPair
+	for _, pair := range lockPairs(block, typeInfo) {
+		if pair.rw {
+			pairs = append(pairs, pair)
+		}
+	}
+	return pairs
+}
+
+// renameCall returns a copy of stmt, a method call or deferred method call, calling method instead.
+func renameCall(stmt ast.Stmt, method string) ast.Stmt {
+	call := stmtCall(stmt)
+	sel := call.Fun.(*ast.SelectorExpr)
+	renamed := &ast.CallExpr{
+		Fun:    &ast.SelectorExpr{X: sel.X, Sel: &ast.Ident{NamePos: sel.Sel.NamePos, Name: method}},
+		Lparen: call.Lparen,
+		Rparen: call.Rparen,
+	}
+	if deferStmt, ok := stmt.(*ast.DeferStmt); ok {
+		return &ast.DeferStmt{Defer: deferStmt.Defer, Call: renamed}
+	}
+	return &ast.ExprStmt{X: renamed}
+}
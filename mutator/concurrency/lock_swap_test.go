@@ -0,0 +1,73 @@
+package concurrency
+
+import (
+	"go/ast"
+	"testing"
+)
+
+func TestLockSwapName(t *testing.T) {
+	mut := LockSwap{}
+
+	if got, want := mut.Name(), "Concurrency_LOCK_SWAP"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestLockSwapCanMutate(t *testing.T) {
+	mut := LockSwap{}
+
+	if mut.CanMutate(&ast.BlockStmt{}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestLockSwapCanMutateWithType(t *testing.T) {
+	mut := LockSwap{}
+
+	tests := []struct {
+		name string
+		decl string
+		want bool
+	}{
+		{name: "read lock is mutable", decl: "func (c *counter) get", want: true},
+		{name: "write lock of read-write mutex is mutable", decl: "func (c *counter) set", want: true},
+		{name: "plain mutex is not mutable", decl: "func (c *counter) inc", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			block, info := body(t, tc.decl)
+
+			if got := mut.CanMutateWithType(block, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLockSwapMutateWithType(t *testing.T) {
+	mut := LockSwap{}
+
+	tests := []struct {
+		name string
+		decl string
+		want string
+	}{
+		{name: "read lock becomes write lock", decl: "func (c *counter) get", want: "{\n\tc.rw.Lock()\n\tn := c.n\n\tc.rw.Unlock()\n\treturn n\n}"},
+		{name: "write lock becomes read lock", decl: "func (c *counter) set", want: "{\n\tc.rw.RLock()\n\tdefer c.rw.RUnlock()\n\tc.n = v\n}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			block, info := body(t, tc.decl)
+			original := print(t, block)
+
+			if got := print(t, mut.MutateWithType(block, info)); got != tc.want {
+				t.Fatalf("MutateWithType() printed %q, want %q", got, tc.want)
+			}
+			if print(t, block) != original {
+				t.Fatalf("MutateWithType() modified the original block")
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// SelectDefaultRemoval removes the default clause of select statements, making them block
+// until a channel is ready.
+// select { case v := <-ch: a; default: b } -> select { case v := <-ch: a }
+// A blocking select can hang the tests; with type information selects polling in a for loop
+// without a condition are skipped, and other hanging mutants are killed by the runner's timeout.
+type SelectDefaultRemoval struct{}
+
+func (m SelectDefaultRemoval) Name() string {
+	return "Concurrency_SELECT_DEFAULT_REMOVE"
+}
+
+func (m SelectDefaultRemoval) CanMutate(node ast.Node) bool {
+	clause, ok := node.(*ast.CommClause)
+	return ok && clause.Comm == nil
+}
+
+// CanMutateWithType additionally skips the default clause of a select without other clauses,
+// which would block forever, of a select within a for loop without a condition, which usually
+// polls for the loop's exit, and clauses whose removal leaves a variable or import unused.
+func (m SelectDefaultRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) || len(typeutil.Unused(typeInfo, node)) > 0 {
+		return false
+	}
+	file := typeutil.File(typeInfo, node.Pos())
+	if file == nil {
+		return true
+	}
+	body, ok := astutil.Parent(file, node).(*ast.BlockStmt)
+	return !ok || (len(body.List) > 1 && !inEndlessLoop(file, body))
+}
+
+// inEndlessLoop reports whether n lies within a for loop without a condition in the same function.
+func inEndlessLoop(file *ast.File, n ast.Node) bool {
+	for n := astutil.Parent(file, n); n != nil; n = astutil.Parent(file, n) {
+		switch n := n.(type) {
+		case *ast.ForStmt:
+			if n.Cond == nil {
+				return true
+			}
+		case *ast.FuncLit, *ast.FuncDecl:
+			return false
+		}
+	}
+	return false
+}
+
+// Mutate returns nil, which removes the clause from its select statement.
+func (m SelectDefaultRemoval) Mutate(node ast.Node) ast.Node {
+	return nil
+}
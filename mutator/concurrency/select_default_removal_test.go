@@ -0,0 +1,71 @@
+package concurrency
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestSelectDefaultRemovalName(t *testing.T) {
+	mut := SelectDefaultRemoval{}
+
+	if got, want := mut.Name(), "Concurrency_SELECT_DEFAULT_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestSelectDefaultRemovalCanMutate(t *testing.T) {
+	mut := SelectDefaultRemoval{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "default clause is mutable", node: &ast.CommClause{}, want: true},
+		{name: "receive clause is not mutable", node: &ast.CommClause{Comm: &ast.ExprStmt{}}, want: false},
+		{name: "switch default is not mutable", node: &ast.CaseClause{}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSelectDefaultRemovalCanMutateWithType(t *testing.T) {
+	mut := SelectDefaultRemoval{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "default next to other clauses is mutable", text: "default:\n\t}\n\tselect", want: true},
+		{name: "only clause is not mutable", text: "default:\n\t}\n\tvar", want: false},
+		{name: "default in loop with condition is mutable", text: "default:\n\t\t\treturn", want: true},
+		{name: "default in endless loop is not mutable", text: "default:\n\t\t\tch <- 1", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CommClause](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSelectDefaultRemovalMutate(t *testing.T) {
+	mut := SelectDefaultRemoval{}
+
+	if got := mut.Mutate(&ast.CommClause{}); got != nil {
+		t.Fatalf("Mutate() = %#v, want nil", got)
+	}
+}
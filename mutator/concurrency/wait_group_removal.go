@@ -0,0 +1,48 @@
+package concurrency
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// WaitGroupRemoval removes calls of sync.WaitGroup's Add and Done methods.
+// wg.Add(1) -> (removed)
+// defer wg.Done() -> (removed)
+type WaitGroupRemoval struct{}
+
+func (m WaitGroupRemoval) Name() string {
+	return "Concurrency_WAITGROUP_REMOVE"
+}
+
+// CanMutate reports false: wait groups are recognised by their type.
+func (m WaitGroupRemoval) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType checks that node is a statement calling or deferring WaitGroup.Add or WaitGroup.Done.
+func (m WaitGroupRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	stmt, ok := node.(ast.Stmt)
+	if !ok || typeInfo == nil {
+		return false
+	}
+	call := stmtCall(stmt)
+	if call == nil {
+		return false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	return ok && (sel.Sel.Name == "Add" || sel.Sel.Name == "Done") && syncMethodType(typeInfo, sel) == "WaitGroup"
+}
+
+func (m WaitGroupRemoval) Mutate(node ast.Node) ast.Node {
+	return &ast.EmptyStmt{Semicolon: node.Pos(), Implicit: true}
+}
+
+// MutateWithType removes the statement like Mutate, keeping variables only used by it in use.
+func (m WaitGroupRemoval) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	return removeStmt(node.(ast.Stmt), typeInfo)
+}
+
+// Race reports true: goroutines no longer waited for are best detected by the race detector.
+func (m WaitGroupRemoval) Race() bool {
+	return true
+}
@@ -0,0 +1,67 @@
+package concurrency
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestWaitGroupRemovalName(t *testing.T) {
+	mut := WaitGroupRemoval{}
+
+	if got, want := mut.Name(), "Concurrency_WAITGROUP_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestWaitGroupRemovalCanMutate(t *testing.T) {
+	mut := WaitGroupRemoval{}
+
+	if mut.CanMutate(&ast.ExprStmt{X: &ast.CallExpr{Fun: &ast.SelectorExpr{X: ast.NewIdent("wg"), Sel: ast.NewIdent("Done")}}}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestWaitGroupRemovalCanMutateWithType(t *testing.T) {
+	mut := WaitGroupRemoval{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "add is mutable", text: "wg.Add(1)", want: true},
+		{name: "deferred done is mutable", text: "defer wg.Done()", want: true},
+		{name: "wait is not mutable", text: "wg.Wait()", want: false},
+		{name: "mutex call is not mutable", text: "local.Lock()", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[ast.Stmt](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWaitGroupRemovalMutate(t *testing.T) {
+	mut := WaitGroupRemoval{}
+	node, info := testutil.Find[ast.Stmt](t, source, "wg.Add(1)")
+
+	if _, ok := mut.Mutate(node).(*ast.EmptyStmt); !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.EmptyStmt", mut.Mutate(node))
+	}
+	if _, ok := mut.MutateWithType(node, info).(*ast.EmptyStmt); !ok {
+		t.Fatalf("MutateWithType() returned %T, want *ast.EmptyStmt", mut.MutateWithType(node, info))
+	}
+}
+
+func TestWaitGroupRemovalRace(t *testing.T) {
+	if !(WaitGroupRemoval{}).Race() {
+		t.Fatalf("Race() = false, want true")
+	}
+}
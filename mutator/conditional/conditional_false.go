@@ -0,0 +1,92 @@
+package conditional
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ConditionalFalse replaces the conditions of if and for statements and the guards of switch cases with false.
+// if cond -> if false
+// for cond -> for false
+// case a, b: -> case false:
+// Conditions that are the only use of a variable or package are short-circuited: if false && (cond)
+type ConditionalFalse struct{}
+
+func (m ConditionalFalse) Name() string {
+	return "Conditional_FALSE"
+}
+
+func (m ConditionalFalse) CanMutate(node ast.Node) bool {
+	cond := condition(node)
+	return cond != nil && !isLiteral(cond, "false")
+}
+
+// CanMutateWithType additionally accepts switch cases whose guards are all boolean.
+func (m ConditionalFalse) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if clause, ok := node.(*ast.CaseClause); ok && booleanCase(node, typeInfo) {
+		return len(clause.List) != 1 || !isLiteral(clause.List[0], "false")
+	}
+	return m.CanMutate(node)
+}
+
+func (m ConditionalFalse) Mutate(node ast.Node) ast.Node {
+	return replaceWithLiteral(node, "false", hasInit(node))
+}
+
+// MutateWithType also short-circuits conditions that are the only use of a local variable or
+// imported package, which would otherwise become unused.
+func (m ConditionalFalse) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	return replaceWithLiteral(node, "false", hasInit(node) || keepsUsed(node, typeInfo))
+}
+
+// replaceWithLiteral replaces the condition of node, or all of its case guards, with a single boolean literal.
+// When shortCircuit is set the condition is kept behind the literal instead (false && cond, true || cond),
+// so the variables and packages it refers to stay in use.
+func replaceWithLiteral(node ast.Node, value string, shortCircuit bool) ast.Node {
+	return replaceConditions(node, func(cond ast.Expr) ast.Expr {
+		literal := &ast.Ident{NamePos: cond.Pos(), Name: value}
+		if !shortCircuit {
+			return literal
+		}
+		op := token.LAND
+		if value == "true" {
+			op = token.LOR
+		}
+		return &ast.BinaryExpr{X: literal, OpPos: cond.Pos(), Op: op, Y: &ast.ParenExpr{X: cond}}
+	})
+}
+
+// hasInit reports whether node is an if or for statement with an init statement, whose variables
+// may only be used by the condition.
+func hasInit(node ast.Node) bool {
+	switch stmt := node.(type) {
+	case *ast.IfStmt:
+		return stmt.Init != nil
+	case *ast.ForStmt:
+		return stmt.Init != nil
+	}
+	return false
+}
+
+// keepsUsed reports whether the condition or case guards of node are the only use of a local
+// variable or imported package.
+func keepsUsed(node ast.Node, typeInfo *types.Info) bool {
+	var conds []ast.Node
+	if clause, ok := node.(*ast.CaseClause); ok {
+		for _, expr := range clause.List {
+			conds = append(conds, expr)
+		}
+	} else if cond := condition(node); cond != nil {
+		conds = append(conds, cond)
+	}
+	return len(typeutil.Unused(typeInfo, conds...)) > 0
+}
+
+// isLiteral reports whether expr is the predeclared identifier value, e.g. true.
+func isLiteral(expr ast.Expr, value string) bool {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	return ok && ident.Name == value
+}
@@ -0,0 +1,120 @@
+package conditional
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestConditionalFalseName(t *testing.T) {
+	mut := ConditionalFalse{}
+
+	if got, want := mut.Name(), "Conditional_FALSE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestConditionalFalseCanMutate(t *testing.T) {
+	mut := ConditionalFalse{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{
+			name: "if statement is mutable",
+			node: &ast.IfStmt{Cond: ast.NewIdent("ok")},
+			want: true,
+		},
+		{
+			name: "for statement is mutable",
+			node: &ast.ForStmt{Cond: ast.NewIdent("ok")},
+			want: true,
+		},
+		{
+			name: "if false is not mutable",
+			node: &ast.IfStmt{Cond: &ast.ParenExpr{X: ast.NewIdent("false")}},
+			want: false,
+		},
+		{
+			name: "range statement is not mutable",
+			node: &ast.RangeStmt{},
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConditionalFalseCanMutateWithType(t *testing.T) {
+	mut := ConditionalFalse{}
+	clauses, info := caseClauses(t)
+
+	if !mut.CanMutateWithType(clauses[0], info) {
+		t.Fatalf("CanMutateWithType() = false for boolean guards, want true")
+	}
+	if mut.CanMutateWithType(clauses[1], info) {
+		t.Fatalf("CanMutateWithType() = true for values compared against a tag, want false")
+	}
+}
+
+func TestConditionalFalseMutate(t *testing.T) {
+	mut := ConditionalFalse{}
+	clauses, _ := caseClauses(t)
+
+	original := &ast.ForStmt{Cond: ast.NewIdent("ok"), Body: &ast.BlockStmt{}}
+	mutated := mut.Mutate(original).(*ast.ForStmt)
+	if got := types.ExprString(mutated.Cond); got != "false" {
+		t.Fatalf("Mutate() condition = %s, want false", got)
+	}
+	if got := types.ExprString(original.Cond); got != "ok" {
+		t.Fatalf("Mutate() modified the original condition to %s", got)
+	}
+
+	clause := mut.Mutate(clauses[0]).(*ast.CaseClause)
+	if len(clause.List) != 1 || types.ExprString(clause.List[0]) != "false" {
+		t.Fatalf("Mutate() guards = %v, want [false]", clause.List)
+	}
+	withInit := &ast.IfStmt{Init: &ast.AssignStmt{}, Cond: ast.NewIdent("ok"), Body: &ast.BlockStmt{}}
+	if got := types.ExprString(mut.Mutate(withInit).(*ast.IfStmt).Cond); got != "false && (ok)" {
+		t.Fatalf("Mutate() condition with init statement = %s, want false && (ok)", got)
+	}
+}
+
+func TestConditionalFalseMutateWithType(t *testing.T) {
+	src := `package sample
+
+import "strings"
+
+func f(s string) {
+	n := len(s)
+	if n > 0 {
+	}
+	if strings.HasPrefix(s, "x") {
+	}
+	if s != "" {
+	}
+}
+`
+	file, info := testutil.Check(t, token.NewFileSet(), src)
+	body := file.Decls[len(file.Decls)-1].(*ast.FuncDecl).Body.List
+
+	mut := ConditionalFalse{}
+	for i, want := range []string{"false && (n > 0)", `false && (strings.HasPrefix(s, "x"))`, "false"} {
+		if got := types.ExprString(mut.MutateWithType(body[i+1], info).(*ast.IfStmt).Cond); got != want {
+			t.Fatalf("MutateWithType() condition = %s, want %s", got, want)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package conditional
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// ConditionalNegation negates the conditions of if and for statements and the guards of switch cases.
+// if cond -> if !(cond)
+// for cond -> for !(cond)
+// case a, b: -> case !(a || b):
+type ConditionalNegation struct{}
+
+func (m ConditionalNegation) Name() string {
+	return "Conditional_NEGATE"
+}
+
+// CanMutate accepts if and for statements with a condition. Switch cases need
+// type information to tell boolean guards from values compared against a tag.
+func (m ConditionalNegation) CanMutate(node ast.Node) bool {
+	return condition(node) != nil
+}
+
+// CanMutateWithType additionally accepts switch cases whose guards are all boolean.
+func (m ConditionalNegation) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) || booleanCase(node, typeInfo)
+}
+
+func (m ConditionalNegation) Mutate(node ast.Node) ast.Node {
+	return replaceConditions(node, negate)
+}
+
+// condition returns the condition of an if or for statement, or nil.
+func condition(node ast.Node) ast.Expr {
+	switch stmt := node.(type) {
+	case *ast.IfStmt:
+		return stmt.Cond
+	case *ast.ForStmt:
+		return stmt.Cond
+	}
+	return nil
+}
+
+// booleanCase reports whether node is a switch case whose guards are all boolean expressions.
+func booleanCase(node ast.Node, typeInfo *types.Info) bool {
+	clause, ok := node.(*ast.CaseClause)
+	if !ok || len(clause.List) == 0 || typeInfo == nil {
+		return false
+	}
+	for _, expr := range clause.List {
+		t := typeInfo.TypeOf(expr)
+		if t == nil {
+			return false
+		}
+		basic, ok := t.Underlying().(*types.Basic)
+		if !ok || basic.Info()&types.IsBoolean == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// replaceConditions returns a copy of node whose condition is replaced by the result of fn.
+// The guards of a case clause are replaced by a single guard, fn of their disjunction (a || b).
+func replaceConditions(node ast.Node, fn func(ast.Expr) ast.Expr) ast.Node {
+	switch stmt := node.(type) {
+	case *ast.IfStmt:
+		cloned := *stmt
+		cloned.Cond = fn(stmt.Cond)
+		return &cloned
+	case *ast.ForStmt:
+		cloned := *stmt
+		cloned.Cond = fn(stmt.Cond)
+		return &cloned
+	case *ast.CaseClause:
+		cloned := *stmt
+		cond := stmt.List[0]
+		for _, expr := range stmt.List[1:] {
+			cond = &ast.BinaryExpr{X: cond, OpPos: expr.Pos(), Op: token.LOR, Y: expr}
+		}
+		cloned.List = []ast.Expr{fn(cond)}
+		return &cloned
+	}
+	return node
+}
+
+// negate returns !x, parenthesizing x unless it is a primary expression.
+func negate(x ast.Expr) ast.Expr {
+	switch x.(type) {
+	case *ast.Ident, *ast.CallExpr, *ast.SelectorExpr, *ast.IndexExpr, *ast.ParenExpr, *ast.UnaryExpr:
+	default:
+		x = &ast.ParenExpr{X: x}
+	}
+	return &ast.UnaryExpr{OpPos: x.Pos(), Op: token.NOT, X: x}
+}
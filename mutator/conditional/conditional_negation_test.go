@@ -0,0 +1,163 @@
+package conditional
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+func f(a, b int, ok bool) {
+	switch {
+	case a > b, ok:
+	}
+	switch a {
+	case 1:
+	}
+	switch ok {
+	case true:
+	}
+}
+`
+
+// caseClauses type-checks source and returns its case clauses in source order.
+func caseClauses(t *testing.T) ([]*ast.CaseClause, *types.Info) {
+	t.Helper()
+	file, info := testutil.Check(t, token.NewFileSet(), source)
+
+	var clauses []*ast.CaseClause
+	ast.Inspect(file, func(n ast.Node) bool {
+		if clause, ok := n.(*ast.CaseClause); ok {
+			clauses = append(clauses, clause)
+		}
+		return true
+	})
+	return clauses, info
+}
+
+func TestConditionalNegationName(t *testing.T) {
+	mut := ConditionalNegation{}
+
+	if got, want := mut.Name(), "Conditional_NEGATE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestConditionalNegationCanMutate(t *testing.T) {
+	mut := ConditionalNegation{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{
+			name: "if statement is mutable",
+			node: &ast.IfStmt{Cond: ast.NewIdent("ok")},
+			want: true,
+		},
+		{
+			name: "for statement with condition is mutable",
+			node: &ast.ForStmt{Cond: ast.NewIdent("ok")},
+			want: true,
+		},
+		{
+			name: "for statement without condition is not mutable",
+			node: &ast.ForStmt{},
+			want: false,
+		},
+		{
+			name: "case clause needs type information",
+			node: &ast.CaseClause{List: []ast.Expr{ast.NewIdent("ok")}},
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConditionalNegationCanMutateWithType(t *testing.T) {
+	mut := ConditionalNegation{}
+	clauses, info := caseClauses(t)
+
+	tests := []struct {
+		name   string
+		clause int
+		want   bool
+	}{
+		{name: "boolean guards are mutable", clause: 0, want: true},
+		{name: "values compared against a tag are not mutable", clause: 1, want: false},
+		{name: "boolean values compared against a tag are mutable", clause: 2, want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutateWithType(clauses[tc.clause], info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConditionalNegationMutate(t *testing.T) {
+	mut := ConditionalNegation{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want string
+	}{
+		{
+			name: "binary condition is parenthesized",
+			node: &ast.IfStmt{Cond: &ast.BinaryExpr{X: ast.NewIdent("a"), Op: token.GTR, Y: ast.NewIdent("b")}, Body: &ast.BlockStmt{}},
+			want: "!(a > b)",
+		},
+		{
+			name: "identifier condition",
+			node: &ast.ForStmt{Cond: ast.NewIdent("ok"), Body: &ast.BlockStmt{}},
+			want: "!ok",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mutated := mut.Mutate(tc.node)
+
+			if mutated == tc.node {
+				t.Fatalf("Mutate() returned the original node")
+			}
+			if got := types.ExprString(condition(mutated)); got != tc.want {
+				t.Fatalf("Mutate() condition = %s, want %s", got, tc.want)
+			}
+			if got := types.ExprString(condition(tc.node)); got == tc.want {
+				t.Fatalf("Mutate() modified the original node")
+			}
+		})
+	}
+}
+
+func TestConditionalNegationMutateCaseClause(t *testing.T) {
+	mut := ConditionalNegation{}
+	clauses, _ := caseClauses(t)
+
+	mutated := mut.Mutate(clauses[0]).(*ast.CaseClause)
+
+	if len(mutated.List) != 1 {
+		t.Fatalf("Mutate() returned %d guards, want 1", len(mutated.List))
+	}
+	if got := types.ExprString(mutated.List[0]); got != "!(a > b || ok)" {
+		t.Fatalf("guard = %s, want !(a > b || ok)", got)
+	}
+}
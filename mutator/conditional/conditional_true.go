@@ -0,0 +1,40 @@
+package conditional
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// ConditionalTrue replaces the conditions of if statements and the guards of switch cases with true.
+// if cond -> if true
+// case a, b: -> case true:
+// Conditions that are the only use of a variable or package are short-circuited: if true || (cond)
+// For loops are left alone, since an always true condition makes most of them infinite.
+type ConditionalTrue struct{}
+
+func (m ConditionalTrue) Name() string {
+	return "Conditional_TRUE"
+}
+
+func (m ConditionalTrue) CanMutate(node ast.Node) bool {
+	stmt, ok := node.(*ast.IfStmt)
+	return ok && !isLiteral(stmt.Cond, "true")
+}
+
+// CanMutateWithType additionally accepts switch cases whose guards are all boolean.
+func (m ConditionalTrue) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if clause, ok := node.(*ast.CaseClause); ok && booleanCase(node, typeInfo) {
+		return len(clause.List) != 1 || !isLiteral(clause.List[0], "true")
+	}
+	return m.CanMutate(node)
+}
+
+func (m ConditionalTrue) Mutate(node ast.Node) ast.Node {
+	return replaceWithLiteral(node, "true", hasInit(node))
+}
+
+// MutateWithType also short-circuits conditions that are the only use of a local variable or
+// imported package, which would otherwise become unused.
+func (m ConditionalTrue) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	return replaceWithLiteral(node, "true", hasInit(node) || keepsUsed(node, typeInfo))
+}
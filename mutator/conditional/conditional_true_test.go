@@ -0,0 +1,79 @@
+package conditional
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+)
+
+func TestConditionalTrueName(t *testing.T) {
+	mut := ConditionalTrue{}
+
+	if got, want := mut.Name(), "Conditional_TRUE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestConditionalTrueCanMutate(t *testing.T) {
+	mut := ConditionalTrue{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{
+			name: "if statement is mutable",
+			node: &ast.IfStmt{Cond: ast.NewIdent("ok")},
+			want: true,
+		},
+		{
+			name: "if true is not mutable",
+			node: &ast.IfStmt{Cond: ast.NewIdent("true")},
+			want: false,
+		},
+		{
+			name: "for statement is not mutable",
+			node: &ast.ForStmt{Cond: ast.NewIdent("ok")},
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConditionalTrueCanMutateWithType(t *testing.T) {
+	mut := ConditionalTrue{}
+	clauses, info := caseClauses(t)
+
+	if !mut.CanMutateWithType(clauses[0], info) {
+		t.Fatalf("CanMutateWithType() = false for boolean guards, want true")
+	}
+	if mut.CanMutateWithType(clauses[2], info) {
+		t.Fatalf("CanMutateWithType() = true for case true, want false")
+	}
+}
+
+func TestConditionalTrueMutate(t *testing.T) {
+	mut := ConditionalTrue{}
+	clauses, _ := caseClauses(t)
+
+	original := &ast.IfStmt{Cond: ast.NewIdent("ok"), Body: &ast.BlockStmt{}}
+	mutated := mut.Mutate(original).(*ast.IfStmt)
+	if got := types.ExprString(mutated.Cond); got != "true" {
+		t.Fatalf("Mutate() condition = %s, want true", got)
+	}
+
+	clause := mut.Mutate(clauses[0]).(*ast.CaseClause)
+	if len(clause.List) != 1 || types.ExprString(clause.List[0]) != "true" {
+		t.Fatalf("Mutate() guards = %v, want [true]", clause.List)
+	}
+}
@@ -0,0 +1,56 @@
+package context_handling
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// CancelRemoval removes calls of context cancel functions, so the context is only cancelled
+// by its parent or deadline.
+// cancel() -> (removed)
+// defer cancel() -> (removed)
+type CancelRemoval struct{}
+
+func (m CancelRemoval) Name() string {
+	return "Context_CANCEL_REMOVE"
+}
+
+// CanMutate reports false: cancel functions are only known from type information.
+func (m CancelRemoval) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType accepts call and defer statements calling a context.CancelFunc or
+// context.CancelCauseFunc.
+func (m CancelRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	var call *ast.CallExpr
+	switch stmt := node.(type) {
+	case *ast.ExprStmt:
+		call, _ = stmt.X.(*ast.CallExpr)
+	case *ast.DeferStmt:
+		call = stmt.Call
+	}
+	if call == nil || typeInfo == nil {
+		return false
+	}
+	tv, ok := typeInfo.Types[call.Fun]
+	if !ok || !tv.IsValue() {
+		return false
+	}
+	return isNamed(tv.Type, "CancelFunc") || isNamed(tv.Type, "CancelCauseFunc")
+}
+
+func (m CancelRemoval) Mutate(node ast.Node) ast.Node {
+	return &ast.EmptyStmt{Semicolon: node.Pos(), Implicit: true}
+}
+
+// MutateWithType removes the statement like Mutate, but keeps a cancel function that is only
+// called by the removed statement in use with a blank assignment.
+func (m CancelRemoval) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	if assign := typeutil.BlankAssign(typeutil.Unused(typeInfo, node)); assign != nil {
+		return assign
+	}
+	return m.Mutate(node)
+}
@@ -0,0 +1,67 @@
+package context_handling
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestCancelRemovalName(t *testing.T) {
+	mut := CancelRemoval{}
+
+	if got, want := mut.Name(), "Context_CANCEL_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestCancelRemovalCanMutate(t *testing.T) {
+	mut := CancelRemoval{}
+
+	if mut.CanMutate(&ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("cancel")}}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestCancelRemovalCanMutateWithType(t *testing.T) {
+	mut := CancelRemoval{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "deferred cancel is mutable", text: "defer cancel()", want: true},
+		{name: "cancel call is mutable", text: "cancel()\n\treturn", want: true},
+		{name: "cancel cause call is mutable", text: "stop(nil)", want: true},
+		{name: "other call is not mutable", text: `fetch(local, "local")`, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[ast.Stmt](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCancelRemovalMutateWithType(t *testing.T) {
+	mut := CancelRemoval{}
+
+	node, info := testutil.Find[ast.Stmt](t, source, "defer cancel()")
+	if _, ok := mut.MutateWithType(node, info).(*ast.EmptyStmt); !ok {
+		t.Fatalf("MutateWithType() didn't return *ast.EmptyStmt for a cancel called elsewhere")
+	}
+
+	node, info = testutil.Find[ast.Stmt](t, source, "stop(nil)")
+	assign, ok := mut.MutateWithType(node, info).(*ast.AssignStmt)
+	if !ok {
+		t.Fatalf("MutateWithType() didn't return *ast.AssignStmt for a cancel only called once")
+	}
+	if ident, ok := assign.Rhs[0].(*ast.Ident); !ok || ident.Name != "stop" {
+		t.Fatalf("MutateWithType() assigned %#v, want stop", assign.Rhs[0])
+	}
+}
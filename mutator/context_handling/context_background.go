@@ -0,0 +1,124 @@
+package context_handling
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ContextBackground replaces context arguments with context.Background(), dropping the caller's
+// deadline, cancellation and values.
+// f(ctx, x) -> f(context.Background(), x)
+type ContextBackground struct{}
+
+func (m ContextBackground) Name() string {
+	return "Context_BACKGROUND"
+}
+
+// CanMutate reports false: context parameters are only known from type information.
+func (m ContextBackground) CanMutate(node ast.Node) bool {
+	return false
+}
+
+func (m ContextBackground) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.MutateWithType(node, typeInfo) != node
+}
+
+// Mutate returns the node unchanged, since context parameters can't be identified without type information.
+func (m ContextBackground) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+// MutateWithType replaces arguments passed to context.Context parameters. Arguments that
+// already are context.Background() or context.TODO() are skipped, and so are arguments whose
+// removal would leave a variable or import unused.
+func (m ContextBackground) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	expr, ok := node.(ast.Expr)
+	if !ok || typeInfo == nil {
+		return node
+	}
+	if tv, ok := typeInfo.Types[expr]; !ok || !tv.IsValue() || tv.IsNil() {
+		return node
+	}
+	file := typeutil.File(typeInfo, expr.Pos())
+	if file == nil {
+		return node
+	}
+	call, ok := astutil.Parent(file, expr).(*ast.CallExpr)
+	if !ok || call.Fun == expr || !isNamed(paramType(typeInfo, call, expr), "Context") {
+		return node
+	}
+	if isBackground(typeInfo, expr) || len(typeutil.Unused(typeInfo, expr)) > 0 {
+		return node
+	}
+	name, _, ok := typeutil.PackageName(typeInfo, expr.Pos(), "context", "context")
+	if !ok {
+		return node
+	}
+	return &ast.CallExpr{
+		Fun: &ast.SelectorExpr{X: &ast.Ident{NamePos: expr.Pos(), Name: name}, Sel: ast.NewIdent("Background")},
+	}
+}
+
+// Imports returns the context package, which the file may need to import for context.Background.
+func (m ContextBackground) Imports() []string {
+	return []string{"context"}
+}
+
+// paramType returns the type of the parameter arg is passed to in call, or nil when call is a
+// conversion or builtin, or arg is spread into a variadic parameter.
+func paramType(typeInfo *types.Info, call *ast.CallExpr, arg ast.Expr) types.Type {
+	tv, ok := typeInfo.Types[call.Fun]
+	if !ok || tv.IsType() || tv.IsBuiltin() {
+		return nil
+	}
+	sig, ok := tv.Type.Underlying().(*types.Signature)
+	if !ok {
+		return nil
+	}
+	params := sig.Params()
+	for i, a := range call.Args {
+		if a != arg {
+			continue
+		}
+		if !sig.Variadic() || i < params.Len()-1 {
+			return params.At(i).Type()
+		}
+		if call.Ellipsis.IsValid() {
+			return nil
+		}
+		if slice, ok := params.At(params.Len() - 1).Type().(*types.Slice); ok {
+			return slice.Elem()
+		}
+	}
+	return nil
+}
+
+// isNamed reports whether t is the named type of the context package with the given name.
+func isNamed(t types.Type, name string) bool {
+	if t == nil {
+		return false
+	}
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == name
+}
+
+// isBackground reports whether expr is a call of context.Background or context.TODO.
+func isBackground(typeInfo *types.Info, expr ast.Expr) bool {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	fn, ok := typeInfo.Uses[sel.Sel].(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == "context" && (fn.Name() == "Background" || fn.Name() == "TODO")
+}
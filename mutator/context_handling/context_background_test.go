@@ -0,0 +1,97 @@
+package context_handling
+
+import (
+	"bytes"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+import "context"
+
+func fetch(ctx context.Context, key string) error { return ctx.Err() }
+
+func all(ctxs ...context.Context) {}
+
+func handle(ctx context.Context) error {
+	_ = fetch(context.TODO(), "todo")
+	all(ctx, nil)
+	all([]context.Context{ctx}...)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	local, stop := context.WithCancelCause(ctx)
+	fetch(local, "local")
+	stop(nil)
+	cancel()
+	return fetch(ctx, "key")
+}
+`
+
+func TestContextBackgroundName(t *testing.T) {
+	mut := ContextBackground{}
+
+	if got, want := mut.Name(), "Context_BACKGROUND"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestContextBackgroundCanMutate(t *testing.T) {
+	mut := ContextBackground{}
+
+	if mut.CanMutate(ast.NewIdent("ctx")) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestContextBackgroundCanMutateWithType(t *testing.T) {
+	mut := ContextBackground{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "context argument is mutable", text: `ctx, "key"`, want: true},
+		{name: "variadic context argument is mutable", text: "ctx, nil", want: true},
+		{name: "nil argument is not mutable", text: "nil)\n\tall", want: false},
+		{name: "spread argument is not mutable", text: "[]context.Context{ctx}...", want: false},
+		{name: "todo argument is not mutable", text: "context.TODO()", want: false},
+		{name: "non-context argument is not mutable", text: `"key"`, want: false},
+		{name: "argument of context constructor is mutable", text: "ctx)\n\tdefer", want: true},
+		{name: "argument keeping a variable used is not mutable", text: `local, "local"`, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[ast.Expr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContextBackgroundMutateWithType(t *testing.T) {
+	mut := ContextBackground{}
+	node, info := testutil.Find[ast.Expr](t, source, `ctx, "key"`)
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), mut.MutateWithType(node, info)); err != nil {
+		t.Fatalf("failed to print: %v", err)
+	}
+	if got, want := buf.String(), "context.Background()"; got != want {
+		t.Fatalf("MutateWithType() = %q, want %q", got, want)
+	}
+}
+
+func TestContextBackgroundImports(t *testing.T) {
+	if got := (ContextBackground{}).Imports(); len(got) != 1 || got[0] != "context" {
+		t.Fatalf("Imports() = %v, want [context]", got)
+	}
+}
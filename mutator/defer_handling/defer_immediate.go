@@ -0,0 +1,23 @@
+package defer_handling
+
+import (
+	"go/ast"
+)
+
+// DeferImmediate runs deferred calls immediately instead of when the function returns.
+// defer mu.Unlock() -> mu.Unlock()
+type DeferImmediate struct{}
+
+func (m DeferImmediate) Name() string {
+	return "Defer_IMMEDIATE"
+}
+
+func (m DeferImmediate) CanMutate(node ast.Node) bool {
+	_, ok := node.(*ast.DeferStmt)
+	return ok
+}
+
+func (m DeferImmediate) Mutate(node ast.Node) ast.Node {
+	stmt := node.(*ast.DeferStmt)
+	return &ast.ExprStmt{X: stmt.Call}
+}
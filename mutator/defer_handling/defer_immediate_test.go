@@ -0,0 +1,48 @@
+package defer_handling
+
+import (
+	"go/ast"
+	"testing"
+)
+
+func TestDeferImmediateName(t *testing.T) {
+	mut := DeferImmediate{}
+
+	if got, want := mut.Name(), "Defer_IMMEDIATE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestDeferImmediateCanMutate(t *testing.T) {
+	mut := DeferImmediate{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "defer statement is mutable", node: &ast.DeferStmt{Call: &ast.CallExpr{Fun: ast.NewIdent("f")}}, want: true},
+		{name: "go statement is not mutable", node: &ast.GoStmt{Call: &ast.CallExpr{Fun: ast.NewIdent("f")}}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDeferImmediateMutate(t *testing.T) {
+	mut := DeferImmediate{}
+	call := &ast.CallExpr{Fun: &ast.SelectorExpr{X: ast.NewIdent("mu"), Sel: ast.NewIdent("Unlock")}}
+
+	mutated, ok := mut.Mutate(&ast.DeferStmt{Call: call}).(*ast.ExprStmt)
+	if !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.ExprStmt", mutated)
+	}
+	if mutated.X != call {
+		t.Fatalf("Mutate() = %#v, want the deferred call", mutated.X)
+	}
+}
@@ -0,0 +1,40 @@
+package defer_handling
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// DeferRemoval removes deferred calls, such as the Close of an opened file.
+// defer f.Close() -> (removed)
+type DeferRemoval struct{}
+
+func (m DeferRemoval) Name() string {
+	return "Defer_REMOVE"
+}
+
+func (m DeferRemoval) CanMutate(node ast.Node) bool {
+	_, ok := node.(*ast.DeferStmt)
+	return ok
+}
+
+// CanMutateWithType additionally skips deferred calls that are the only use of a package
+// a blank assignment can't keep in use, as in defer f(pkg.T{}).
+func (m DeferRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && typeutil.Keepable(typeInfo, node)
+}
+
+func (m DeferRemoval) Mutate(node ast.Node) ast.Node {
+	return &ast.EmptyStmt{Semicolon: node.Pos(), Implicit: true}
+}
+
+// MutateWithType removes the statement like Mutate, but keeps local variables and imported
+// packages that are only referenced by the deferred call in use with a blank assignment.
+func (m DeferRemoval) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	if assign := typeutil.BlankAssign(typeutil.Unused(typeInfo, node)); assign != nil {
+		return assign
+	}
+	return m.Mutate(node)
+}
@@ -0,0 +1,67 @@
+package defer_handling
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestDeferRemovalName(t *testing.T) {
+	mut := DeferRemoval{}
+
+	if got, want := mut.Name(), "Defer_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestDeferRemovalCanMutate(t *testing.T) {
+	mut := DeferRemoval{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "defer statement is mutable", node: &ast.DeferStmt{Call: &ast.CallExpr{Fun: ast.NewIdent("f")}}, want: true},
+		{name: "go statement is not mutable", node: &ast.GoStmt{Call: &ast.CallExpr{Fun: ast.NewIdent("f")}}, want: false},
+		{name: "call statement is not mutable", node: &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("f")}}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDeferRemovalMutate(t *testing.T) {
+	mut := DeferRemoval{}
+
+	if _, ok := mut.Mutate(&ast.DeferStmt{Call: &ast.CallExpr{Fun: ast.NewIdent("f")}}).(*ast.EmptyStmt); !ok {
+		t.Fatalf("Mutate() didn't return *ast.EmptyStmt")
+	}
+}
+
+func TestDeferRemovalMutateWithTypeKeepsVariablesUsed(t *testing.T) {
+	src := `package sample
+
+func run(open func() func()) {
+	closer := open()
+	defer closer()
+}
+`
+	file, info := testutil.Check(t, token.NewFileSet(), src)
+	stmt := file.Decls[0].(*ast.FuncDecl).Body.List[1]
+
+	assign, ok := DeferRemoval{}.MutateWithType(stmt, info).(*ast.AssignStmt)
+	if !ok {
+		t.Fatalf("MutateWithType() didn't return *ast.AssignStmt")
+	}
+	if ident, ok := assign.Rhs[0].(*ast.Ident); !ok || ident.Name != "closer" {
+		t.Fatalf("MutateWithType() assigned %#v, want closer", assign.Rhs[0])
+	}
+}
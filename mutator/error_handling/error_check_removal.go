@@ -0,0 +1,84 @@
+package error_handling
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ErrorCheckRemoval removes error checks, as if the error had not occurred.
+// if err != nil { return err } -> (removed)
+// if err := f(); err != nil { ... } else { ... } -> { err := f(); _ = err; { ... } }
+type ErrorCheckRemoval struct{}
+
+func (m ErrorCheckRemoval) Name() string {
+	return "Error_CHECK_REMOVE"
+}
+
+// CanMutate reports false: error checks are recognised by the type of the compared value,
+// so the mutation is only discovered when type information is available.
+func (m ErrorCheckRemoval) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType checks that node is an if statement comparing a value of type error to nil.
+func (m ErrorCheckRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	stmt := errorCheck(node, typeInfo)
+	return stmt != nil && typeutil.Keepable(typeInfo, stmt.Cond, stmt.Body)
+}
+
+// Mutate removes the error check, keeping its init statement and else branch.
+func (m ErrorCheckRemoval) Mutate(node ast.Node) ast.Node {
+	stmt, ok := node.(*ast.IfStmt)
+	if !ok {
+		return node
+	}
+	return removeCheck(stmt, nil)
+}
+
+// MutateWithType removes the error check like Mutate, but keeps local variables and imported
+// packages only used by the check in use with a blank assignment, so the mutant still compiles.
+func (m ErrorCheckRemoval) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	stmt := errorCheck(node, typeInfo)
+	if stmt == nil {
+		return m.Mutate(node)
+	}
+	return removeCheck(stmt, typeutil.BlankAssign(typeutil.Unused(typeInfo, stmt.Cond, stmt.Body)))
+}
+
+// removeCheck replaces stmt by its init statement, keep and else branch, in a block
+// when there is more than one of them or the init statement declares variables.
+func removeCheck(stmt *ast.IfStmt, keep ast.Stmt) ast.Stmt {
+	var list []ast.Stmt
+	for _, s := range []ast.Stmt{stmt.Init, keep, stmt.Else} {
+		if s != nil {
+			list = append(list, s)
+		}
+	}
+	switch {
+	case len(list) == 0:
+		return &ast.EmptyStmt{Semicolon: stmt.Pos(), Implicit: true}
+	case len(list) == 1 && stmt.Init == nil:
+		return list[0]
+	}
+	return &ast.BlockStmt{Lbrace: stmt.Pos(), List: list, Rbrace: stmt.End()}
+}
+
+// errorCheck returns node if it is an if statement whose condition compares a value of type error to nil.
+func errorCheck(node ast.Node, typeInfo *types.Info) *ast.IfStmt {
+	stmt, ok := node.(*ast.IfStmt)
+	if !ok || typeInfo == nil {
+		return nil
+	}
+	cond, ok := ast.Unparen(stmt.Cond).(*ast.BinaryExpr)
+	if !ok || cond.Op != token.NEQ {
+		return nil
+	}
+	if (typeInfo.Types[cond.Y].IsNil() && typeutil.IsError(typeInfo.TypeOf(cond.X))) ||
+		(typeInfo.Types[cond.X].IsNil() && typeutil.IsError(typeInfo.TypeOf(cond.Y))) {
+		return stmt
+	}
+	return nil
+}
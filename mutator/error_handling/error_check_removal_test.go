@@ -0,0 +1,144 @@
+package error_handling
+
+import (
+	"bytes"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+type myErr struct{}
+
+func (*myErr) Error() string { return "" }
+
+func open(name string) (*os.File, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", name, err)
+	}
+	return f, nil
+}
+
+func parse(s string) (int, error) {
+	if n, err := strconv.Atoi(s); err != nil {
+		return 0, err
+	} else {
+		return n, nil
+	}
+}
+
+func custom(e *myErr) error {
+	if e != nil {
+		return e
+	}
+	return nil
+}
+
+func reversed() error {
+	_, err := os.Getwd()
+	if nil != err {
+		prefix := "cwd"
+		return fmt.Errorf("%s: %v %w", prefix, err, err)
+	}
+	return nil
+}
+
+func local() error {
+	err := fmt.Errorf("x")
+	return err
+}
+`
+
+func printNode(t *testing.T, node ast.Node) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), node); err != nil {
+		t.Fatalf("failed to print: %v", err)
+	}
+	return buf.String()
+}
+
+func TestErrorCheckRemovalName(t *testing.T) {
+	mut := ErrorCheckRemoval{}
+
+	if got, want := mut.Name(), "Error_CHECK_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestErrorCheckRemovalCanMutate(t *testing.T) {
+	mut := ErrorCheckRemoval{}
+
+	if mut.CanMutate(&ast.IfStmt{Cond: ast.NewIdent("ok")}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestErrorCheckRemovalMutate(t *testing.T) {
+	mut := ErrorCheckRemoval{}
+
+	original := &ast.IfStmt{Cond: ast.NewIdent("ok"), Body: &ast.BlockStmt{}}
+
+	if _, ok := mut.Mutate(original).(*ast.EmptyStmt); !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.EmptyStmt", mut.Mutate(original))
+	}
+}
+
+func TestErrorCheckRemovalMutateWithType(t *testing.T) {
+	mut := ErrorCheckRemoval{}
+	stmts, info := testutil.Nodes[*ast.IfStmt](t, source)
+
+	tests := []struct {
+		name string
+		stmt int
+		want string // empty when the statement can't be mutated
+	}{
+		{
+			name: "check is removed keeping the error variable used",
+			stmt: 0,
+			want: "_ = err",
+		},
+		{
+			name: "init statement and else branch are kept",
+			stmt: 1,
+			want: "{\n\tn, err := strconv.Atoi(s)\n\t_ = err\n\t{\n\t\treturn n, nil\n\t}\n}",
+		},
+		{
+			name: "concrete error types are not checks",
+			stmt: 2,
+			want: "",
+		},
+		{
+			name: "nil on the left",
+			stmt: 3,
+			want: "_ = err",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stmt := stmts[tc.stmt]
+
+			if got := mut.CanMutateWithType(stmt, info); got != (tc.want != "") {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want != "")
+			}
+			if tc.want == "" {
+				return
+			}
+			if got := printNode(t, mut.MutateWithType(stmt, info)); got != tc.want {
+				t.Fatalf("MutateWithType() printed %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package error_handling
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ErrorReturnNil replaces returned error variables with nil, swallowing the error.
+// return v, err -> return v, nil
+type ErrorReturnNil struct{}
+
+func (m ErrorReturnNil) Name() string {
+	return "Error_RETURN_NIL"
+}
+
+// CanMutate reports false: error variables are recognised by their type.
+func (m ErrorReturnNil) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType checks that node is a return statement returning a variable of type error.
+func (m ErrorReturnNil) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	ret, ok := node.(*ast.ReturnStmt)
+	if !ok || typeInfo == nil {
+		return false
+	}
+	for _, expr := range ret.Results {
+		if errorVariable(expr, typeInfo) {
+			return true
+		}
+	}
+	return false
+}
+
+// Mutate returns the node unchanged, since error variables can't be identified without type information.
+func (m ErrorReturnNil) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+func (m ErrorReturnNil) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	ret, ok := node.(*ast.ReturnStmt)
+	if !ok || typeInfo == nil {
+		return node
+	}
+
+	mutated := &ast.ReturnStmt{Return: ret.Return, Results: make([]ast.Expr, len(ret.Results))}
+	var replaced []ast.Node
+	for i, expr := range ret.Results {
+		mutated.Results[i] = expr
+		if errorVariable(expr, typeInfo) {
+			mutated.Results[i] = &ast.Ident{NamePos: expr.Pos(), Name: "nil"}
+			replaced = append(replaced, expr)
+		}
+	}
+	// keep an error variable only used by the return statement in use, e.g. `{ _ = err; return nil }`;
+	// error variables never refer to packages, so they can always be kept
+	return typeutil.KeepUsed(typeInfo, mutated, replaced...)
+}
+
+// errorVariable reports whether expr is a variable of type error.
+func errorVariable(expr ast.Expr, typeInfo *types.Info) bool {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	v, ok := typeInfo.Uses[ident].(*types.Var)
+	return ok && typeutil.IsError(v.Type())
+}
@@ -0,0 +1,56 @@
+package error_handling
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestErrorReturnNilName(t *testing.T) {
+	mut := ErrorReturnNil{}
+
+	if got, want := mut.Name(), "Error_RETURN_NIL"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestErrorReturnNilCanMutate(t *testing.T) {
+	mut := ErrorReturnNil{}
+
+	if mut.CanMutate(&ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("err")}}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestErrorReturnNilMutateWithType(t *testing.T) {
+	mut := ErrorReturnNil{}
+	rets, info := testutil.Nodes[*ast.ReturnStmt](t, source)
+
+	tests := []struct {
+		name string
+		ret  int
+		want string // empty when the statement can't be mutated
+	}{
+		{name: "wrapped error is not a variable", ret: 1, want: ""},
+		{name: "returned error variable", ret: 3, want: "return 0, nil"},
+		{name: "concrete error type", ret: 5, want: ""},
+		{name: "error variable only used by the return", ret: 9, want: "{\n\t_ = err\n\treturn nil\n}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ret := rets[tc.ret]
+
+			if got := mut.CanMutateWithType(ret, info); got != (tc.want != "") {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want != "")
+			}
+			if tc.want == "" {
+				return
+			}
+			if got := printNode(t, mut.MutateWithType(ret, info)); got != tc.want {
+				t.Fatalf("MutateWithType() printed %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
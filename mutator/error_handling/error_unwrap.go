@@ -0,0 +1,83 @@
+package error_handling
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ErrorUnwrap replaces a wrapped error with the bare error it wraps.
+// fmt.Errorf("open %s: %w", name, err) -> err
+// Calls whose other arguments are the only use of a variable or package are left alone,
+// since an expression can't keep them in use.
+type ErrorUnwrap struct{}
+
+func (m ErrorUnwrap) Name() string {
+	return "Error_UNWRAP"
+}
+
+// CanMutate reports false: fmt.Errorf and the wrapped error are resolved through type information.
+func (m ErrorUnwrap) CanMutate(node ast.Node) bool {
+	return false
+}
+
+func (m ErrorUnwrap) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	call, wrapped := wrappedError(node, typeInfo)
+	if wrapped == nil {
+		return false
+	}
+	dropped := []ast.Node{call.Fun}
+	for _, arg := range call.Args {
+		if arg != wrapped {
+			dropped = append(dropped, arg)
+		}
+	}
+	return len(typeutil.Unused(typeInfo, dropped...)) == 0
+}
+
+// Mutate returns the node unchanged, since the wrapped error can't be identified without type information.
+func (m ErrorUnwrap) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+func (m ErrorUnwrap) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	if _, wrapped := wrappedError(node, typeInfo); wrapped != nil {
+		return wrapped
+	}
+	return node
+}
+
+// wrappedError returns node as a fmt.Errorf call with a constant format containing %w,
+// and its only argument of type error.
+func wrappedError(node ast.Node, typeInfo *types.Info) (*ast.CallExpr, ast.Expr) {
+	call, ok := node.(*ast.CallExpr)
+	if !ok || typeInfo == nil || len(call.Args) < 2 || call.Ellipsis.IsValid() {
+		return nil, nil
+	}
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil, nil
+	}
+	fn, ok := typeInfo.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" || fn.Name() != "Errorf" {
+		return nil, nil
+	}
+	format := typeInfo.Types[call.Args[0]].Value
+	if format == nil || format.Kind() != constant.String || !strings.Contains(constant.StringVal(format), "%w") {
+		return nil, nil
+	}
+
+	var wrapped ast.Expr
+	for _, arg := range call.Args[1:] {
+		if typeutil.IsError(typeInfo.TypeOf(arg)) {
+			if wrapped != nil {
+				return nil, nil
+			}
+			wrapped = arg
+		}
+	}
+	return call, wrapped
+}
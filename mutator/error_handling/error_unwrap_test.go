@@ -0,0 +1,56 @@
+package error_handling
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestErrorUnwrapName(t *testing.T) {
+	mut := ErrorUnwrap{}
+
+	if got, want := mut.Name(), "Error_UNWRAP"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestErrorUnwrapCanMutate(t *testing.T) {
+	mut := ErrorUnwrap{}
+
+	if mut.CanMutate(&ast.CallExpr{Fun: ast.NewIdent("f")}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestErrorUnwrapMutateWithType(t *testing.T) {
+	mut := ErrorUnwrap{}
+	calls, info := testutil.Nodes[*ast.CallExpr](t, source)
+
+	tests := []struct {
+		name string
+		call int
+		want string // empty when the call can't be mutated
+	}{
+		{name: "os.Open is not fmt.Errorf", call: 0, want: ""},
+		{name: "wrapped error", call: 1, want: "err"},
+		{name: "variable only used by the call", call: 4, want: ""},
+		{name: "format without %w", call: 5, want: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			call := calls[tc.call]
+
+			if got := mut.CanMutateWithType(call, info); got != (tc.want != "") {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want != "")
+			}
+			if tc.want == "" {
+				return
+			}
+			if got := printNode(t, mut.MutateWithType(call, info)); got != tc.want {
+				t.Fatalf("MutateWithType() printed %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package function_call
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+)
+
+// ArgumentSwap swaps the first two adjacent arguments of the same type.
+// copyFile(src, dst) -> copyFile(dst, src)
+// Arguments that are the same expression or constant are not swapped.
+type ArgumentSwap struct{}
+
+func (m ArgumentSwap) Name() string {
+	return "Call_ARGUMENT_SWAP"
+}
+
+// CanMutate reports false: argument and parameter types are only known from type information.
+func (m ArgumentSwap) CanMutate(node ast.Node) bool {
+	return false
+}
+
+func (m ArgumentSwap) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	_, i := swappable(node, typeInfo)
+	return i >= 0
+}
+
+// Mutate returns the node unchanged, since argument types can't be compared without type information.
+func (m ArgumentSwap) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+func (m ArgumentSwap) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	call, i := swappable(node, typeInfo)
+	if i < 0 {
+		return node
+	}
+	mutated := *call
+	mutated.Args = make([]ast.Expr, len(call.Args))
+	copy(mutated.Args, call.Args)
+	mutated.Args[i], mutated.Args[i+1] = call.Args[i+1], call.Args[i]
+	return &mutated
+}
+
+// swappable returns the call and the index of the first argument that can be swapped with the
+// next one: both have identical types and are passed to parameters of identical types.
+// The index is -1 when no arguments can be swapped.
+func swappable(node ast.Node, typeInfo *types.Info) (*ast.CallExpr, int) {
+	call, ok := node.(*ast.CallExpr)
+	if !ok || typeInfo == nil {
+		return nil, -1
+	}
+	sig := signature(typeInfo, call)
+	if sig == nil {
+		return nil, -1
+	}
+	n := len(call.Args)
+	if call.Ellipsis.IsValid() {
+		// the spread slice has a different type than the elements
+		n--
+	}
+	for i := 0; i+1 < n; i++ {
+		x, y := call.Args[i], call.Args[i+1]
+		xt, yt := typeInfo.Types[x], typeInfo.Types[y]
+		if xt.Type == nil || yt.Type == nil || !types.Identical(xt.Type, yt.Type) {
+			continue
+		}
+		px, py := paramType(sig, i), paramType(sig, i+1)
+		if px == nil || py == nil || !types.Identical(px, py) {
+			continue
+		}
+		if types.ExprString(x) == types.ExprString(y) {
+			continue
+		}
+		if xt.Value != nil && yt.Value != nil && constant.Compare(xt.Value, token.EQL, yt.Value) {
+			continue
+		}
+		return call, i
+	}
+	return nil, -1
+}
+
+// paramType returns the type of the i-th parameter of sig, taking the element type of a
+// variadic parameter, or nil when sig has no such parameter.
+func paramType(sig *types.Signature, i int) types.Type {
+	params := sig.Params()
+	if !sig.Variadic() || i < params.Len()-1 {
+		if i >= params.Len() {
+			return nil
+		}
+		return params.At(i).Type()
+	}
+	slice, ok := params.At(params.Len() - 1).Type().(*types.Slice)
+	if !ok {
+		return nil
+	}
+	return slice.Elem()
+}
@@ -0,0 +1,58 @@
+package function_call
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestArgumentSwapName(t *testing.T) {
+	mut := ArgumentSwap{}
+
+	if got, want := mut.Name(), "Call_ARGUMENT_SWAP"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestArgumentSwapCanMutate(t *testing.T) {
+	mut := ArgumentSwap{}
+
+	if mut.CanMutate(&ast.CallExpr{Fun: ast.NewIdent("f"), Args: []ast.Expr{ast.NewIdent("a"), ast.NewIdent("b")}}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestArgumentSwapMutateWithType(t *testing.T) {
+	mut := ArgumentSwap{}
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "swaps arguments of the same type", text: "move(origin(), n, 2)", want: "move(origin(), 2, n)"},
+		{name: "swaps variadic arguments", text: "sum(n, 1, 2)", want: "sum(1, n, 2)"},
+		{name: "skips identical arguments", text: "move(p, n, n)", want: "move(p, n, n)"},
+		{name: "skips spread arguments", text: "sum([]int{n}...)", want: "sum([]int{…}...)"},
+		{name: "skips single argument", text: "count(items)", want: "count(items)"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+			original := types.ExprString(node)
+
+			if got := types.ExprString(mut.MutateWithType(node, info).(ast.Expr)); got != tc.want {
+				t.Fatalf("MutateWithType() = %s, want %s", got, tc.want)
+			}
+			if got, want := mut.CanMutateWithType(node, info), tc.want != original; got != want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, want)
+			}
+			if types.ExprString(node) != original {
+				t.Fatalf("MutateWithType() modified the original call")
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package function_call
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ArgumentZero replaces an argument with the zero value of its type.
+// repeat(s, n) -> repeat(s, 0)
+// Arguments whose zero value is nil are left to Nil_ARGUMENT, and format strings of
+// printf-style calls are only mutated when FormatStrings is set, since vet rejects an
+// empty format with arguments.
+type ArgumentZero struct {
+	// FormatStrings enables mutating the format string of printf-style calls
+	FormatStrings bool
+}
+
+func (m ArgumentZero) Name() string {
+	return "Call_ARGUMENT_ZERO"
+}
+
+// CanMutate reports false: the zero value of an argument depends on its type.
+func (m ArgumentZero) CanMutate(node ast.Node) bool {
+	return false
+}
+
+func (m ArgumentZero) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.MutateWithType(node, typeInfo) != node
+}
+
+// Mutate returns the node unchanged, since zero values can't be built without type information.
+func (m ArgumentZero) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+// MutateWithType replaces arguments of function and method calls that aren't zero already.
+// Arguments of builtins and conversions, spread arguments, format strings and arguments whose
+// removal would leave a variable or import unused are skipped.
+func (m ArgumentZero) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	expr, ok := node.(ast.Expr)
+	if !ok || typeInfo == nil {
+		return node
+	}
+	tv, ok := typeInfo.Types[expr]
+	if !ok || !tv.IsValue() || typeutil.IsZero(typeInfo, expr) {
+		return node
+	}
+	file := typeutil.File(typeInfo, expr.Pos())
+	if file == nil {
+		return node
+	}
+	call, ok := astutil.Parent(file, expr).(*ast.CallExpr)
+	if !ok || call.Fun == expr || signature(typeInfo, call) == nil {
+		return node
+	}
+	if call.Ellipsis.IsValid() && call.Args[len(call.Args)-1] == expr {
+		return node
+	}
+	if !m.FormatStrings && typeutil.IsFormatString(typeInfo, call, expr) {
+		return node
+	}
+
+	zero := typeutil.TypedZeroValue(typeInfo, expr.Pos(), tv.Type)
+	if zero == nil || len(typeutil.Unused(typeInfo, expr)) > 0 {
+		return node
+	}
+	switch zero := zero.(type) {
+	case *ast.Ident:
+		if zero.Name == "nil" {
+			return node
+		}
+	case *ast.CallExpr:
+		if ident, ok := zero.Args[0].(*ast.Ident); ok && ident.Name == "nil" {
+			return node
+		}
+	}
+	return zero
+}
+
+// signature returns the signature of the function or method called by call, or nil when call
+// is a conversion or calls a builtin.
+func signature(typeInfo *types.Info, call *ast.CallExpr) *types.Signature {
+	tv, ok := typeInfo.Types[call.Fun]
+	if !ok || tv.IsType() || tv.IsBuiltin() {
+		return nil
+	}
+	sig, _ := tv.Type.Underlying().(*types.Signature)
+	return sig
+}
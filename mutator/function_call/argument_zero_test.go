@@ -0,0 +1,79 @@
+package function_call
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestArgumentZeroName(t *testing.T) {
+	mut := ArgumentZero{}
+
+	if got, want := mut.Name(), "Call_ARGUMENT_ZERO"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestArgumentZeroCanMutate(t *testing.T) {
+	mut := ArgumentZero{}
+
+	if mut.CanMutate(ast.NewIdent("n")) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestArgumentZeroCanMutateWithType(t *testing.T) {
+	tests := []struct {
+		name          string
+		text          string
+		formatStrings bool
+		want          bool
+	}{
+		{name: "int argument is mutable", text: "n, 2)", want: true},
+		{name: "constant argument is mutable", text: "2)", want: true},
+		{name: "struct argument is mutable", text: "p, n, n", want: true},
+		{name: "variadic argument is mutable", text: "n, 1, 2", want: true},
+		{name: "slice argument is left to nil mutators", text: "items)\n\td", want: false},
+		{name: "spread argument is not mutable", text: "[]int{n}...", want: false},
+		{name: "conversion argument is not mutable", text: "n)\n\t_, _, _", want: false},
+		{name: "callee is not mutable", text: "move(p", want: false},
+		{name: "format string is not mutable", text: `"%d items"`, want: false},
+		{name: "format string is mutable with format strings", text: `"%d items"`, formatStrings: true, want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mut := ArgumentZero{FormatStrings: tc.formatStrings}
+			node, info := testutil.Find[ast.Expr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestArgumentZeroMutateWithType(t *testing.T) {
+	mut := ArgumentZero{}
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "int argument", text: "n, 2)", want: "0"},
+		{name: "struct argument", text: "p, n, n", want: "point{}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[ast.Expr](t, source, tc.text)
+
+			if got := types.ExprString(mut.MutateWithType(node, info).(ast.Expr)); got != tc.want {
+				t.Fatalf("MutateWithType() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package function_call
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// CallZero replaces the result of a call with the zero value of its type, as if the callee
+// did nothing.
+// n := count(items) -> n := 0
+// d := time.Since(start) -> d := time.Duration(0)
+type CallZero struct{}
+
+func (m CallZero) Name() string {
+	return "Call_ZERO"
+}
+
+// CanMutate reports false: the result type of a call is only known from type information.
+func (m CallZero) CanMutate(node ast.Node) bool {
+	return false
+}
+
+func (m CallZero) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.MutateWithType(node, typeInfo) != node
+}
+
+// Mutate returns the node unchanged, since zero values can't be built without type information.
+func (m CallZero) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+// MutateWithType replaces calls with a single result that aren't conversions or constant.
+// Builtins such as len and append are left to the collection mutators.
+// Calls are skipped where the constant zero would not compile, e.g. as a divisor or next to
+// another constant, and where their arguments are the only use of a variable or import.
+func (m CallZero) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	call, ok := node.(*ast.CallExpr)
+	if !ok || typeInfo == nil {
+		return node
+	}
+	tv, ok := typeInfo.Types[call]
+	if !ok || !tv.IsValue() || tv.Value != nil {
+		return node
+	}
+	if _, ok := tv.Type.(*types.Tuple); ok {
+		return node
+	}
+	if fun, ok := typeInfo.Types[call.Fun]; !ok || fun.IsType() || fun.IsBuiltin() {
+		return node
+	}
+	file := typeutil.File(typeInfo, call.Pos())
+	if file == nil {
+		return node
+	}
+	switch astutil.Parent(file, call).(type) {
+	case *ast.ExprStmt, *ast.GoStmt, *ast.DeferStmt:
+		return node
+	}
+
+	zero := typeutil.TypedZeroValue(typeInfo, call.Pos(), tv.Type)
+	if zero == nil || len(typeutil.Unused(typeInfo, call)) > 0 {
+		return node
+	}
+	if value := zeroConstant(tv.Type); value != nil {
+		if typeutil.ConstantContext(typeInfo, call) || typeutil.DuplicatesConstant(typeInfo, call, value) {
+			return node
+		}
+	}
+	if _, ok := zero.(*ast.CompositeLit); ok && inHeader(file, call) {
+		// T{} in an if, for or switch header would be parsed as the statement's body
+		zero = &ast.ParenExpr{X: zero}
+	}
+	return zero
+}
+
+// zeroConstant returns the constant zero value of t, or nil when t isn't a basic type.
+func zeroConstant(t types.Type) constant.Value {
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok {
+		return nil
+	}
+	switch {
+	case basic.Info()&types.IsBoolean != 0:
+		return constant.MakeBool(false)
+	case basic.Info()&types.IsString != 0:
+		return constant.MakeString("")
+	case basic.Info()&types.IsNumeric != 0:
+		return constant.MakeInt64(0)
+	}
+	return nil
+}
+
+// inHeader reports whether expr is part of the header of an if, for, range or switch statement.
+func inHeader(file *ast.File, expr ast.Expr) bool {
+	found := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		if found || n == nil || n.Pos() > expr.Pos() || n.End() < expr.End() {
+			return false
+		}
+		var body *ast.BlockStmt
+		switch stmt := n.(type) {
+		case *ast.IfStmt:
+			body = stmt.Body
+		case *ast.ForStmt:
+			body = stmt.Body
+		case *ast.RangeStmt:
+			body = stmt.Body
+		case *ast.SwitchStmt:
+			body = stmt.Body
+		case *ast.TypeSwitchStmt:
+			body = stmt.Body
+		}
+		if body != nil && expr.End() <= body.Lbrace {
+			found = true
+		}
+		return true
+	})
+	return found
+}
@@ -0,0 +1,121 @@
+package function_call
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+import (
+	"strings"
+	"time"
+)
+
+type point struct{ x, y int }
+
+func count(items []string) int { return len(items) }
+
+func origin() point { return point{} }
+
+func move(p point, dx, dy int) point { return point{p.x + dx, p.y + dy} }
+
+func sum(xs ...int) int { return 0 }
+
+func logf(format string, args ...any) {}
+
+func run(items []string, start time.Time) {
+	n := count(items)
+	d := time.Since(start)
+	upper := strings.ToUpper("a")
+	_ = 10 / count(items)
+	_ = count(items) + 1
+	count(items)
+	if origin() == (point{}) {
+	}
+	p := move(origin(), n, 2)
+	_ = move(p, n, n)
+	logf("%d items", n)
+	_ = sum(n, 1, 2)
+	_ = sum([]int{n}...)
+	_ = int64(n)
+	_, _, _ = d, upper, p
+	switch count(items) {
+	case 0:
+	}
+}
+`
+
+func TestCallZeroName(t *testing.T) {
+	mut := CallZero{}
+
+	if got, want := mut.Name(), "Call_ZERO"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestCallZeroCanMutate(t *testing.T) {
+	mut := CallZero{}
+
+	if mut.CanMutate(&ast.CallExpr{Fun: ast.NewIdent("f")}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestCallZeroMutateWithType(t *testing.T) {
+	mut := CallZero{}
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "int result", text: "count(items)\n\td :=", want: "0"},
+		{name: "named result", text: "time.Since(start)", want: "time.Duration(0)"},
+		{name: "struct result in header", text: "origin() ==", want: "(point{})"},
+		{name: "struct argument", text: "origin(), n", want: "point{}"},
+		{name: "switch tag", text: "count(items) {", want: "0"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+
+			if !mut.CanMutateWithType(node, info) {
+				t.Fatalf("CanMutateWithType() = false, want true")
+			}
+			if got := types.ExprString(mut.MutateWithType(node, info).(ast.Expr)); got != tc.want {
+				t.Fatalf("MutateWithType() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCallZeroSkipsCalls(t *testing.T) {
+	mut := CallZero{}
+
+	tests := []struct {
+		name string
+		text string
+	}{
+		{name: "only use of an import", text: `strings.ToUpper("a")`},
+		{name: "divisor", text: "count(items)\n\t_ = count"},
+		{name: "next to a constant", text: "count(items) + 1"},
+		{name: "call statement", text: "count(items)\n\tif"},
+		{name: "conversion", text: "int64(n)"},
+		{name: "builtin", text: "len(items)"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+
+			if mut.CanMutateWithType(node, info) {
+				t.Fatalf("CanMutateWithType() = true, want false")
+			}
+		})
+	}
+}
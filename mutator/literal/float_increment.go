@@ -0,0 +1,57 @@
+package literal
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+)
+
+// FloatIncrement mutates floating-point literals to their value plus one, keeping their notation.
+// 2.5 -> 3.5
+// 1e3 -> 1.001e+03
+type FloatIncrement struct{}
+
+func (m FloatIncrement) Name() string {
+	return "Literal_FLOAT_INCREMENT"
+}
+
+func (m FloatIncrement) CanMutate(node ast.Node) bool {
+	_, ok := incrementedFloat(node)
+	return ok
+}
+
+// CanMutateWithType additionally skips literals whose incremented value, or the constant
+// expression enclosing it, overflows its type, e.g. 127.0 used as an int8.
+func (m FloatIncrement) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	value, _ := floatValue(node)
+	return canReplaceFloat(node.(*ast.BasicLit), constant.BinaryOp(value, token.ADD, constant.MakeInt64(1)), typeInfo)
+}
+
+func (m FloatIncrement) Mutate(node ast.Node) ast.Node {
+	lit := node.(*ast.BasicLit)
+	mutated, ok := incrementedFloat(node)
+	if !ok {
+		return node
+	}
+	return &ast.BasicLit{ValuePos: lit.ValuePos, Kind: token.FLOAT, Value: mutated}
+}
+
+// incrementedFloat returns the source of a float literal incremented by one. ok is false when the
+// increment is lost to float64 rounding, as in 1e300, which would make the mutant equivalent.
+func incrementedFloat(node ast.Node) (string, bool) {
+	value, ok := floatValue(node)
+	if !ok {
+		return "", false
+	}
+	incremented := constant.BinaryOp(value, token.ADD, constant.MakeInt64(1))
+	before, _ := constant.Float64Val(value)
+	after, _ := constant.Float64Val(incremented)
+	if before == after {
+		return "", false
+	}
+	return formatFloat(node.(*ast.BasicLit).Value, incremented)
+}
@@ -0,0 +1,95 @@
+package literal
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+)
+
+func TestFloatIncrementName(t *testing.T) {
+	mut := FloatIncrement{}
+
+	if got, want := mut.Name(), "Literal_FLOAT_INCREMENT"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestFloatIncrementCanMutate(t *testing.T) {
+	mut := FloatIncrement{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "float literal is mutable", node: &ast.BasicLit{Kind: token.FLOAT, Value: "2.5"}, want: true},
+		{name: "zero is mutable", node: &ast.BasicLit{Kind: token.FLOAT, Value: "0.0"}, want: true},
+		{name: "increment lost to rounding is not mutable", node: &ast.BasicLit{Kind: token.FLOAT, Value: "1e300"}, want: false},
+		{name: "value beyond float64 is not mutable", node: &ast.BasicLit{Kind: token.FLOAT, Value: "1e400"}, want: false},
+		{name: "imaginary literal is not mutable", node: &ast.BasicLit{Kind: token.IMAG, Value: "2.5i"}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFloatIncrementCanMutateWithType(t *testing.T) {
+	mut := FloatIncrement{}
+	lits, info := literals(t, floatSource, token.FLOAT)
+
+	tests := []struct {
+		name string
+		lit  int
+		want bool
+	}{
+		{name: "float32 is mutable", lit: 0, want: true},
+		{name: "overflowing int8 is not mutable", lit: 1, want: false},
+		{name: "uint is mutable", lit: 2, want: true},
+		{name: "case duplicating another case is not mutable", lit: 3, want: false},
+		{name: "last case is mutable", lit: 4, want: true},
+		{name: "factor overflowing a float32 constant is not mutable", lit: 9, want: false},
+		{name: "factor of an untyped constant is mutable", lit: 12, want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutateWithType(lits[tc.lit], info); got != tc.want {
+				t.Fatalf("CanMutateWithType(%s) = %v, want %v", lits[tc.lit].Value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFloatIncrementMutate(t *testing.T) {
+	mut := FloatIncrement{}
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "2.5", want: "3.5"},
+		{value: "2.", want: "3.0"},
+		{value: ".5", want: "1.5"},
+		{value: "1e3", want: "1.001e+03"},
+		{value: "1E3", want: "1.001E+03"},
+		{value: "0x1p-2", want: "0x1.4p+00"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			mutated := mut.Mutate(&ast.BasicLit{ValuePos: 5, Kind: token.FLOAT, Value: tc.value}).(*ast.BasicLit)
+
+			if mutated.Kind != token.FLOAT || mutated.Value != tc.want {
+				t.Fatalf("Mutate(%s) = %v %s, want FLOAT %s", tc.value, mutated.Kind, mutated.Value, tc.want)
+			}
+			if mutated.ValuePos != 5 {
+				t.Fatalf("Mutate(%s) position = %d, want 5", tc.value, mutated.ValuePos)
+			}
+		})
+	}
+}
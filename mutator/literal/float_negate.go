@@ -0,0 +1,40 @@
+package literal
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+)
+
+// FloatNegate mutates non-zero floating-point literals to their negation.
+// 2.5 -> -2.5
+type FloatNegate struct{}
+
+func (m FloatNegate) Name() string {
+	return "Literal_FLOAT_NEGATE"
+}
+
+func (m FloatNegate) CanMutate(node ast.Node) bool {
+	value, ok := floatValue(node)
+	return ok && constant.Sign(value) != 0
+}
+
+// CanMutateWithType additionally skips literals whose negated value, or the constant expression
+// enclosing it, doesn't fit its type, e.g. a literal converted to an unsigned integer type.
+func (m FloatNegate) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	value, _ := floatValue(node)
+	return canReplaceFloat(node.(*ast.BasicLit), constant.UnaryOp(token.SUB, value, 0), typeInfo)
+}
+
+func (m FloatNegate) Mutate(node ast.Node) ast.Node {
+	lit := node.(*ast.BasicLit)
+	return &ast.UnaryExpr{
+		OpPos: lit.ValuePos,
+		Op:    token.SUB,
+		X:     &ast.BasicLit{ValuePos: lit.ValuePos, Kind: lit.Kind, Value: lit.Value},
+	}
+}
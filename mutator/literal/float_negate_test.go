@@ -0,0 +1,79 @@
+package literal
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+)
+
+func TestFloatNegateName(t *testing.T) {
+	mut := FloatNegate{}
+
+	if got, want := mut.Name(), "Literal_FLOAT_NEGATE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestFloatNegateCanMutate(t *testing.T) {
+	mut := FloatNegate{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "float literal is mutable", node: &ast.BasicLit{Kind: token.FLOAT, Value: "2.5"}, want: true},
+		{name: "zero is not mutable", node: &ast.BasicLit{Kind: token.FLOAT, Value: "0."}, want: false},
+		{name: "string literal is not mutable", node: &ast.BasicLit{Kind: token.STRING, Value: `"2.5"`}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFloatNegateCanMutateWithType(t *testing.T) {
+	mut := FloatNegate{}
+	lits, info := literals(t, floatSource, token.FLOAT)
+
+	tests := []struct {
+		name string
+		lit  int
+		want bool
+	}{
+		{name: "float32 is mutable", lit: 0, want: true},
+		{name: "int8 is mutable", lit: 1, want: true},
+		{name: "uint is not mutable", lit: 2, want: false},
+		{name: "factor of a float32 constant is mutable", lit: 9, want: true},
+		{name: "factor of a uint constant is not mutable", lit: 11, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutateWithType(lits[tc.lit], info); got != tc.want {
+				t.Fatalf("CanMutateWithType(%s) = %v, want %v", lits[tc.lit].Value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFloatNegateMutate(t *testing.T) {
+	mut := FloatNegate{}
+	original := &ast.BasicLit{ValuePos: 5, Kind: token.FLOAT, Value: "2.5"}
+
+	mutated, ok := mut.Mutate(original).(*ast.UnaryExpr)
+	if !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.UnaryExpr", mutated)
+	}
+	if mutated.Op != token.SUB || types.ExprString(mutated) != "-2.5" {
+		t.Fatalf("Mutate() = %s, want -2.5", types.ExprString(mutated))
+	}
+	if mutated.X == original {
+		t.Fatalf("Mutate() reused the original literal")
+	}
+}
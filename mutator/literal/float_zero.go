@@ -0,0 +1,114 @@
+package literal
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// FloatZero mutates non-zero floating-point literals to zero.
+// 2.5 -> 0.0
+type FloatZero struct{}
+
+func (m FloatZero) Name() string {
+	return "Literal_FLOAT_ZERO"
+}
+
+func (m FloatZero) CanMutate(node ast.Node) bool {
+	value, ok := floatValue(node)
+	return ok && constant.Sign(value) != 0
+}
+
+// CanMutateWithType additionally skips literals that would become a zero divisor of a constant
+// or of an integer, since such a division doesn't compile.
+func (m FloatZero) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.CanMutate(node) && canReplaceFloat(node.(*ast.BasicLit), constant.MakeInt64(0), typeInfo)
+}
+
+func (m FloatZero) Mutate(node ast.Node) ast.Node {
+	lit := node.(*ast.BasicLit)
+	return &ast.BasicLit{ValuePos: lit.ValuePos, Kind: token.FLOAT, Value: "0.0"}
+}
+
+// floatValue returns the value of a floating-point literal.
+func floatValue(node ast.Node) (constant.Value, bool) {
+	lit, ok := node.(*ast.BasicLit)
+	if !ok || lit.Kind != token.FLOAT {
+		return nil, false
+	}
+	value := constant.MakeFromLiteral(lit.Value, token.FLOAT, 0)
+	return value, value.Kind() != constant.Unknown
+}
+
+// canReplaceFloat reports whether lit can be replaced by a literal of the given value once the
+// enclosing constant expression is folded: every value along the way must fit its type, the
+// outermost one mustn't become an integer divisor of zero, and it mustn't duplicate another
+// map key or switch case.
+func canReplaceFloat(lit *ast.BasicLit, value constant.Value, typeInfo *types.Info) bool {
+	if typeInfo == nil {
+		return true
+	}
+	file := typeutil.File(typeInfo, lit.Pos())
+	if file == nil {
+		return true
+	}
+	expr, value, ok := typeutil.FoldConstant(typeInfo, file, lit, value)
+	if !ok {
+		return false
+	}
+
+	if t := typeInfo.TypeOf(expr); t != nil && constant.Sign(value) == 0 && isInteger(t) && isDivisor(astutil.Parent(file, expr), expr) {
+		return false
+	}
+	return !typeutil.DuplicatesConstant(typeInfo, expr, value)
+}
+
+// isInteger reports whether t is an integer type.
+func isInteger(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsInteger != 0
+}
+
+// isDivisor reports whether expr is the right operand of a division or remainder.
+func isDivisor(parent ast.Node, expr ast.Expr) bool {
+	switch p := parent.(type) {
+	case *ast.BinaryExpr:
+		return p.Y == expr && (p.Op == token.QUO || p.Op == token.REM)
+	case *ast.AssignStmt:
+		return len(p.Rhs) == 1 && p.Rhs[0] == expr && (p.Tok == token.QUO_ASSIGN || p.Tok == token.REM_ASSIGN)
+	}
+	return false
+}
+
+// formatFloat writes value in the notation of the original literal: hexadecimal, exponent or
+// decimal. It returns false when value has no finite float64 representation.
+func formatFloat(original string, value constant.Value) (string, bool) {
+	f, _ := constant.Float64Val(value)
+	if math.IsInf(f, 0) {
+		return "", false
+	}
+
+	switch {
+	case strings.HasPrefix(original, "0x") || strings.HasPrefix(original, "0X"):
+		return strconv.FormatFloat(f, 'x', -1, 64), true
+	case strings.ContainsAny(original, "eE"):
+		s := strconv.FormatFloat(f, 'e', -1, 64)
+		if strings.Contains(original, "E") {
+			s = strings.ToUpper(s)
+		}
+		return s, true
+	}
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		// keep the literal a float, 3 would be an untyped integer constant
+		s += ".0"
+	}
+	return s, true
+}
@@ -0,0 +1,102 @@
+package literal
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+)
+
+const floatSource = `package sample
+
+var (
+	f32 float32 = 1.5e3
+	i8  int8    = 127.0
+	u   uint    = 2.0
+)
+
+func f(n int, x float64) (int, float64) {
+	switch x {
+	case 1.5, 2.5:
+	}
+	return n / 2.0, x / 2.0 + 0.0
+}
+
+const (
+	half         = 1 / 2.0
+	big  float32 = 3.0 * 1e38
+	six  uint    = 2.0 * 3
+)
+
+var r = 3.0 / 1.5
+`
+
+func TestFloatZeroName(t *testing.T) {
+	mut := FloatZero{}
+
+	if got, want := mut.Name(), "Literal_FLOAT_ZERO"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestFloatZeroCanMutate(t *testing.T) {
+	mut := FloatZero{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "float literal is mutable", node: &ast.BasicLit{Kind: token.FLOAT, Value: "2.5"}, want: true},
+		{name: "hex float literal is mutable", node: &ast.BasicLit{Kind: token.FLOAT, Value: "0x1p-2"}, want: true},
+		{name: "zero is not mutable", node: &ast.BasicLit{Kind: token.FLOAT, Value: "0.0"}, want: false},
+		{name: "integer literal is not mutable", node: &ast.BasicLit{Kind: token.INT, Value: "2"}, want: false},
+		{name: "identifier is not mutable", node: ast.NewIdent("x"), want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFloatZeroCanMutateWithType(t *testing.T) {
+	mut := FloatZero{}
+	lits, info := literals(t, floatSource, token.FLOAT)
+
+	tests := []struct {
+		name string
+		lit  int
+		want bool
+	}{
+		{name: "integer divisor is not mutable", lit: 5, want: false},
+		{name: "float divisor is mutable", lit: 6, want: true},
+		{name: "case value is mutable", lit: 3, want: true},
+		{name: "constant divisor is not mutable", lit: 8, want: false},
+		{name: "constant dividend is mutable", lit: 12, want: true},
+		{name: "float divisor of a constant is not mutable", lit: 13, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutateWithType(lits[tc.lit], info); got != tc.want {
+				t.Fatalf("CanMutateWithType(%s) = %v, want %v", lits[tc.lit].Value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFloatZeroMutate(t *testing.T) {
+	mut := FloatZero{}
+
+	mutated := mut.Mutate(&ast.BasicLit{ValuePos: 5, Kind: token.FLOAT, Value: "2.5"}).(*ast.BasicLit)
+
+	if mutated.Kind != token.FLOAT || mutated.Value != "0.0" {
+		t.Fatalf("Mutate() = %v %s, want FLOAT 0.0", mutated.Kind, mutated.Value)
+	}
+	if mutated.ValuePos != 5 {
+		t.Fatalf("Mutate() position = %d, want 5", mutated.ValuePos)
+	}
+}
@@ -0,0 +1,63 @@
+package literal
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// StringLiteral mutates string literals, so tests have to check output text and map keys.
+// "text" -> ""
+// "" -> "axiom"
+// Import paths and struct tags are never mutated, and neither are directives such as
+// //go:embed, which are comments. Format strings of printf-style calls are only mutated
+// when FormatStrings is set.
+type StringLiteral struct {
+	// FormatStrings enables mutating the format string of printf-style calls
+	FormatStrings bool
+}
+
+func (m StringLiteral) Name() string {
+	return "Literal_STRING"
+}
+
+// CanMutate reports false: without type information string literals can't be told apart
+// from import paths and struct tags, whose mutation would not compile.
+func (m StringLiteral) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType accepts string literals used as values. Import paths and struct tags
+// have no type, so they aren't recorded in typeInfo.Types.
+func (m StringLiteral) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	lit, ok := node.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING || typeInfo == nil {
+		return false
+	}
+	if _, ok := typeInfo.Types[lit]; !ok {
+		return false
+	}
+
+	if file := typeutil.File(typeInfo, lit.Pos()); file != nil && !m.FormatStrings && typeutil.IsFormatString(typeInfo, astutil.Parent(file, lit), lit) {
+		return false
+	}
+	return !typeutil.DuplicatesConstant(typeInfo, lit, constant.MakeString(mutatedValue(lit)))
+}
+
+func (m StringLiteral) Mutate(node ast.Node) ast.Node {
+	lit := node.(*ast.BasicLit)
+	return &ast.BasicLit{ValuePos: lit.ValuePos, Kind: token.STRING, Value: strconv.Quote(mutatedValue(lit))}
+}
+
+// mutatedValue returns the value lit is mutated to: "" for non-empty strings and "axiom" for empty ones.
+func mutatedValue(lit *ast.BasicLit) string {
+	if value, err := strconv.Unquote(lit.Value); err == nil && value == "" {
+		return "axiom"
+	}
+	return ""
+}
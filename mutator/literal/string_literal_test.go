@@ -0,0 +1,121 @@
+package literal
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+import "fmt"
+
+type T struct {
+	Name string ` + "`json:\"name\"`" + `
+}
+
+var m = map[string]int{"a": 1, "": 2}
+
+func f(s string) string {
+	switch s {
+	case "x", "":
+		return fmt.Sprintf("%s!", s)
+	case "y":
+		fmt.Println("done")
+	}
+	return ""
+}
+`
+
+// literals type-checks src and returns its literals of the given kind in source order.
+func literals(t *testing.T, src string, kind token.Token) ([]*ast.BasicLit, *types.Info) {
+	t.Helper()
+	all, info := testutil.Nodes[*ast.BasicLit](t, src)
+
+	var lits []*ast.BasicLit
+	for _, lit := range all {
+		if lit.Kind == kind {
+			lits = append(lits, lit)
+		}
+	}
+	return lits, info
+}
+
+func TestStringLiteralName(t *testing.T) {
+	mut := StringLiteral{}
+
+	if got, want := mut.Name(), "Literal_STRING"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestStringLiteralCanMutate(t *testing.T) {
+	mut := StringLiteral{}
+
+	if mut.CanMutate(&ast.BasicLit{Kind: token.STRING, Value: `"x"`}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestStringLiteralCanMutateWithType(t *testing.T) {
+	lits, info := literals(t, source, token.STRING)
+
+	tests := []struct {
+		name          string
+		lit           int
+		formatStrings bool
+		want          bool
+	}{
+		{name: "import path is not mutable", lit: 0, want: false},
+		{name: "struct tag is not mutable", lit: 1, want: false},
+		{name: "map key duplicating another key is not mutable", lit: 2, want: false},
+		{name: "empty map key is mutable", lit: 3, want: true},
+		{name: "case duplicating another case is not mutable", lit: 4, want: false},
+		{name: "empty case is mutable", lit: 5, want: true},
+		{name: "format string is not mutable", lit: 6, want: false},
+		{name: "format string is mutable when enabled", lit: 6, formatStrings: true, want: true},
+		{name: "other clause duplicating a case is not mutable", lit: 7, want: false},
+		{name: "call argument is mutable", lit: 8, want: true},
+		{name: "returned empty string is mutable", lit: 9, want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mut := StringLiteral{FormatStrings: tc.formatStrings}
+
+			if got := mut.CanMutateWithType(lits[tc.lit], info); got != tc.want {
+				t.Fatalf("CanMutateWithType(%s) = %v, want %v", lits[tc.lit].Value, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStringLiteralMutate(t *testing.T) {
+	mut := StringLiteral{}
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: `"text"`, want: `""`},
+		{value: "`raw`", want: `""`},
+		{value: `""`, want: `"axiom"`},
+		{value: "``", want: `"axiom"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			mutated := mut.Mutate(&ast.BasicLit{ValuePos: 7, Kind: token.STRING, Value: tc.value}).(*ast.BasicLit)
+
+			if mutated.Value != tc.want {
+				t.Fatalf("Mutate() = %s, want %s", mutated.Value, tc.want)
+			}
+			if mutated.ValuePos != 7 {
+				t.Fatalf("Mutate() position = %d, want 7", mutated.ValuePos)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package loop
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// BranchRemoval removes break and continue statements.
+// break -> (removed)
+// continue -> (removed)
+// Labelled statements are kept, since removing them can leave the label unused.
+// With type information a break that is the only way out of a for loop without a condition
+// is kept too, since the loop would never end, and so are branches whose removal changes nothing:
+// breaks leaving a switch or select and continues ending the loop body.
+type BranchRemoval struct{}
+
+func (m BranchRemoval) Name() string {
+	return "Loop_BRANCH_REMOVE"
+}
+
+func (m BranchRemoval) CanMutate(node ast.Node) bool {
+	branch, ok := node.(*ast.BranchStmt)
+	return ok && (branch.Tok == token.BREAK || branch.Tok == token.CONTINUE) && branch.Label == nil
+}
+
+func (m BranchRemoval) Mutate(node ast.Node) ast.Node {
+	return &ast.EmptyStmt{Semicolon: node.Pos(), Implicit: true}
+}
+
+// CanMutateWithType additionally skips a break that is the sole exit of the condition-less
+// for loop it leaves, breaks leaving a switch or select, which mostly end their clause anyway,
+// and continues the loop continues after anyway, found by walking up the file containing them.
+func (m BranchRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	branch := node.(*ast.BranchStmt)
+	file := typeutil.File(typeInfo, branch.Pos())
+	if file == nil {
+		return true
+	}
+	if branch.Tok == token.CONTINUE {
+		return !endsIteration(file, branch)
+	}
+	switch target := breakTarget(file, branch).(type) {
+	case *ast.ForStmt:
+		return target.Cond != nil || otherExit(file, target, branch)
+	case *ast.RangeStmt:
+		return true
+	}
+	return false
+}
+
+// endsIteration reports whether n is the last statement of a loop body, possibly nested in
+// blocks, if statements and clauses that are the last statements of theirs in turn.
+func endsIteration(file *ast.File, n ast.Node) bool {
+	for {
+		parent := astutil.Parent(file, n)
+		var list []ast.Stmt
+		switch p := parent.(type) {
+		case *ast.BlockStmt:
+			list = p.List
+		case *ast.CaseClause:
+			list = p.Body
+		case *ast.CommClause:
+			list = p.Body
+		case *ast.IfStmt, *ast.LabeledStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt:
+		case *ast.ForStmt, *ast.RangeStmt:
+			return true
+		default:
+			return false
+		}
+		if list != nil && list[len(list)-1] != n {
+			return false
+		}
+		n = parent
+	}
+}
+
+// breakTarget returns the innermost for, range, switch or select statement enclosing n
+// in the same function, which an unlabelled break at n leaves.
+func breakTarget(file *ast.File, n ast.Node) ast.Node {
+	for n := astutil.Parent(file, n); n != nil; n = astutil.Parent(file, n) {
+		switch n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt:
+			return n
+		case *ast.FuncLit, *ast.FuncDecl:
+			return nil
+		}
+	}
+	return nil
+}
+
+// otherExit reports whether loop can be left other than through branch: by another break
+// leaving it, a labelled branch to an enclosing statement, a goto, a return or a panic.
+func otherExit(file *ast.File, loop *ast.ForStmt, branch *ast.BranchStmt) bool {
+	found := false
+	ast.Inspect(loop.Body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			found = true
+		case *ast.ExprStmt:
+			found = astutil.Terminates(n)
+		case *ast.BranchStmt:
+			switch {
+			case n == branch:
+			case n.Tok == token.GOTO:
+				found = true
+			case n.Label != nil:
+				found = leavesLoop(file, loop, n)
+			case n.Tok == token.BREAK:
+				found = breakTarget(file, n) == loop
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// leavesLoop reports whether the labelled branch, found in the body of loop, continues
+// or breaks a statement enclosing loop, or breaks loop itself.
+func leavesLoop(file *ast.File, loop *ast.ForStmt, branch *ast.BranchStmt) bool {
+	for n := astutil.Parent(file, branch); n != nil && n != loop; n = astutil.Parent(file, n) {
+		if labeled, ok := n.(*ast.LabeledStmt); ok && labeled.Label.Name == branch.Label.Name {
+			return false
+		}
+	}
+	if labeled, ok := astutil.Parent(file, loop).(*ast.LabeledStmt); ok && labeled.Label.Name == branch.Label.Name {
+		return branch.Tok == token.BREAK
+	}
+	return true
+}
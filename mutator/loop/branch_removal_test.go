@@ -0,0 +1,154 @@
+package loop
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestBranchRemovalName(t *testing.T) {
+	mut := BranchRemoval{}
+
+	if got, want := mut.Name(), "Loop_BRANCH_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestBranchRemovalCanMutate(t *testing.T) {
+	mut := BranchRemoval{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "break is mutable", node: &ast.BranchStmt{Tok: token.BREAK}, want: true},
+		{name: "continue is mutable", node: &ast.BranchStmt{Tok: token.CONTINUE}, want: true},
+		{name: "labelled break is not mutable", node: &ast.BranchStmt{Tok: token.BREAK, Label: ast.NewIdent("l")}, want: false},
+		{name: "fallthrough is not mutable", node: &ast.BranchStmt{Tok: token.FALLTHROUGH}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+const exitSource = `package sample
+
+func f(c chan int) int {
+	for {
+		if <-c > 0 {
+			break
+		}
+	}
+	for {
+		select {
+		case <-c:
+			break
+		}
+		if <-c > 0 {
+			break
+		}
+	}
+	for {
+		if <-c > 0 {
+			break
+		}
+		if <-c < 0 {
+			return 0
+		}
+	}
+outer:
+	for {
+		for range c {
+			break outer
+		}
+		if <-c > 0 {
+			break
+		}
+	}
+	for {
+		go func() {
+			panic("done")
+		}()
+		if <-c > 0 {
+			break
+		}
+	}
+	for i := 0; ; i++ {
+		if i > 0 {
+			break
+		}
+		if <-c > 0 {
+			break
+		}
+	}
+	for i := 0; i < 10; i++ {
+		if <-c > 0 {
+			break
+		}
+	}
+	for i := 0; i < 10; i++ {
+		if i > 5 {
+			continue
+		}
+		c <- i
+	}
+	for range c {
+		switch {
+		case <-c > 0:
+			continue
+		}
+	}
+	return 1
+}
+`
+
+func TestBranchRemovalCanMutateWithType(t *testing.T) {
+	mut := BranchRemoval{}
+	branches, info := testutil.Nodes[*ast.BranchStmt](t, exitSource)
+
+	tests := []struct {
+		name   string
+		branch int
+		want   bool
+	}{
+		{name: "only exit of endless loop", branch: 0, want: false},
+		{name: "break from select", branch: 1, want: false},
+		{name: "only exit besides select break", branch: 2, want: false},
+		{name: "exit besides return", branch: 3, want: true},
+		{name: "labelled break of loop", branch: 4, want: false},
+		{name: "exit besides labelled break", branch: 5, want: true},
+		{name: "exit besides panic in function literal", branch: 6, want: false},
+		{name: "one of two exits", branch: 7, want: true},
+		{name: "exit of loop with condition", branch: 9, want: true},
+		{name: "continue skipping the rest of the body", branch: 10, want: true},
+		{name: "continue ending the body", branch: 11, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutateWithType(branches[tc.branch], info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBranchRemovalMutate(t *testing.T) {
+	mut := BranchRemoval{}
+
+	mutated := mut.Mutate(&ast.BranchStmt{Tok: token.BREAK})
+
+	if _, ok := mutated.(*ast.EmptyStmt); !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.EmptyStmt", mutated)
+	}
+}
@@ -0,0 +1,76 @@
+package loop
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// BreakContinueSwap swaps break and continue statements.
+// continue -> break
+// break -> continue
+// Without type information only continue is mutated, since a break may leave a switch
+// or select statement outside of any loop.
+type BreakContinueSwap struct{}
+
+func (m BreakContinueSwap) Name() string {
+	return "Loop_BREAK_CONTINUE_SWAP"
+}
+
+func (m BreakContinueSwap) CanMutate(node ast.Node) bool {
+	branch, ok := node.(*ast.BranchStmt)
+	return ok && branch.Tok == token.CONTINUE
+}
+
+// CanMutateWithType additionally accepts break statements whose target lies within a loop,
+// found by walking up the file containing them.
+func (m BreakContinueSwap) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	branch, ok := node.(*ast.BranchStmt)
+	if !ok || branch.Tok != token.BREAK {
+		return m.CanMutate(node)
+	}
+	file := typeutil.File(typeInfo, branch.Pos())
+	return file != nil && continueTarget(file, branch)
+}
+
+func (m BreakContinueSwap) Mutate(node ast.Node) ast.Node {
+	branch := node.(*ast.BranchStmt)
+	cloned := *branch
+	if branch.Tok == token.BREAK {
+		cloned.Tok = token.CONTINUE
+	} else {
+		cloned.Tok = token.BREAK
+	}
+	return &cloned
+}
+
+// continueTarget reports whether a continue statement in place of branch would be valid:
+// an unlabelled one needs an enclosing loop in the same function, a labelled one a loop with that label.
+func continueTarget(file *ast.File, branch *ast.BranchStmt) bool {
+	for n := astutil.Parent(file, branch); n != nil; n = astutil.Parent(file, n) {
+		switch n := n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt:
+			if branch.Label == nil {
+				return true
+			}
+		case *ast.LabeledStmt:
+			if branch.Label != nil && n.Label.Name == branch.Label.Name {
+				return isLoop(n.Stmt)
+			}
+		case *ast.FuncLit, *ast.FuncDecl:
+			return false
+		}
+	}
+	return false
+}
+
+func isLoop(stmt ast.Stmt) bool {
+	switch stmt.(type) {
+	case *ast.ForStmt, *ast.RangeStmt:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+package loop
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+func f(s []int, c chan int) int {
+	n := 0
+outer:
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case 0:
+			break
+		case 1:
+			continue
+		case 2:
+			break outer
+		}
+		for range s {
+			continue outer
+		}
+	}
+	switch n {
+	case 0:
+		break
+	}
+	for _, v := range s {
+		if v > 0 {
+			continue
+		}
+		n += v
+	}
+	for range s {
+		for range s {
+			continue
+		}
+		n++
+	}
+	for i := uint(10); i > 0; i-- {
+	}
+	for i := uint(10); i < 0; i++ {
+	}
+	return n
+}
+`
+
+func TestBreakContinueSwapName(t *testing.T) {
+	mut := BreakContinueSwap{}
+
+	if got, want := mut.Name(), "Loop_BREAK_CONTINUE_SWAP"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestBreakContinueSwapCanMutate(t *testing.T) {
+	mut := BreakContinueSwap{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "continue is mutable", node: &ast.BranchStmt{Tok: token.CONTINUE}, want: true},
+		{name: "break needs type information", node: &ast.BranchStmt{Tok: token.BREAK}, want: false},
+		{name: "goto is not mutable", node: &ast.BranchStmt{Tok: token.GOTO}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBreakContinueSwapCanMutateWithType(t *testing.T) {
+	mut := BreakContinueSwap{}
+	branches, info := testutil.Nodes[*ast.BranchStmt](t, source)
+
+	tests := []struct {
+		name   string
+		branch int
+		want   bool
+	}{
+		{name: "break from switch in loop", branch: 0, want: true},
+		{name: "continue", branch: 1, want: true},
+		{name: "labelled break of loop", branch: 2, want: true},
+		{name: "break from switch outside loop", branch: 4, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutateWithType(branches[tc.branch], info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBreakContinueSwapMutate(t *testing.T) {
+	mut := BreakContinueSwap{}
+
+	original := &ast.BranchStmt{Tok: token.BREAK, Label: ast.NewIdent("outer")}
+	mutated := mut.Mutate(original).(*ast.BranchStmt)
+
+	if mutated.Tok != token.CONTINUE || mutated.Label != original.Label {
+		t.Fatalf("Mutate() = %v %v, want continue outer", mutated.Tok, mutated.Label)
+	}
+	if original.Tok != token.BREAK {
+		t.Fatalf("Mutate() modified the original statement")
+	}
+	if back := mut.Mutate(mutated).(*ast.BranchStmt); back.Tok != token.BREAK {
+		t.Fatalf("Mutate(continue) = %v, want break", back.Tok)
+	}
+}
@@ -0,0 +1,103 @@
+package loop
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ConditionBound moves the bound of a loop condition by one, so the loop runs one iteration less.
+// for i := 0; i < len(s); i++ -> for i := 0; i < len(s)-1; i++
+// for i := n; i >= 0; i-- -> for i := n; i >= 0+1; i--
+type ConditionBound struct{}
+
+func (m ConditionBound) Name() string {
+	return "Loop_CONDITION_BOUND"
+}
+
+// CanMutate accepts loop conditions whose bound is an integer literal or a len or cap call,
+// which are known to be integers without type information.
+func (m ConditionBound) CanMutate(node ast.Node) bool {
+	cond := boundCondition(node)
+	if cond == nil {
+		return false
+	}
+	switch y := ast.Unparen(cond.Y).(type) {
+	case *ast.BasicLit:
+		return y.Kind == token.INT && (y.Value != "0" || !lowersBound(cond.Op))
+	case *ast.CallExpr:
+		ident, ok := y.Fun.(*ast.Ident)
+		return ok && (ident.Name == "len" || ident.Name == "cap")
+	}
+	return false
+}
+
+// CanMutateWithType accepts loop conditions whose bound is an integer, except a constant
+// bound whose type can't represent it once moved, like an unsigned zero lowered below zero
+// or a uint8 255 raised to 256 in i > 255.
+func (m ConditionBound) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	cond := boundCondition(node)
+	if cond == nil {
+		return false
+	}
+	tv, ok := typeInfo.Types[cond.Y]
+	if !ok || tv.Type == nil {
+		return m.CanMutate(node)
+	}
+	basic, ok := tv.Type.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsInteger == 0 {
+		return false
+	}
+	if tv.Value != nil {
+		return typeutil.Representable(constant.BinaryOp(tv.Value, boundOp(cond.Op), constant.MakeInt64(1)), tv.Type)
+	}
+	return true
+}
+
+func (m ConditionBound) Mutate(node ast.Node) ast.Node {
+	stmt := node.(*ast.ForStmt)
+	cond := boundCondition(node)
+
+	cloned := *stmt
+	cloned.Cond = &ast.BinaryExpr{
+		X:     cond.X,
+		OpPos: cond.OpPos,
+		Op:    cond.Op,
+		Y:     &ast.BinaryExpr{X: cond.Y, Op: boundOp(cond.Op), Y: &ast.BasicLit{Kind: token.INT, Value: "1"}},
+	}
+	return &cloned
+}
+
+// boundCondition returns the condition of a for statement if it is an ordered comparison.
+func boundCondition(node ast.Node) *ast.BinaryExpr {
+	stmt, ok := node.(*ast.ForStmt)
+	if !ok || stmt.Cond == nil {
+		return nil
+	}
+	cond, ok := ast.Unparen(stmt.Cond).(*ast.BinaryExpr)
+	if !ok {
+		return nil
+	}
+	switch cond.Op {
+	case token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return cond
+	}
+	return nil
+}
+
+// boundOp returns the operator that moves the bound of a comparison by one.
+func boundOp(op token.Token) token.Token {
+	if lowersBound(op) {
+		return token.SUB
+	}
+	return token.ADD
+}
+
+// lowersBound reports whether the bound of a comparison is decremented to run one iteration less:
+// i < n counts up towards n, while i > n counts down towards it.
+func lowersBound(op token.Token) bool {
+	return op == token.LSS || op == token.LEQ
+}
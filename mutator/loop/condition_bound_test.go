@@ -0,0 +1,132 @@
+package loop
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestConditionBoundName(t *testing.T) {
+	mut := ConditionBound{}
+
+	if got, want := mut.Name(), "Loop_CONDITION_BOUND"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestConditionBoundCanMutate(t *testing.T) {
+	mut := ConditionBound{}
+
+	loop := func(op token.Token, y ast.Expr) ast.Node {
+		return &ast.ForStmt{Cond: &ast.BinaryExpr{X: ast.NewIdent("i"), Op: op, Y: y}, Body: &ast.BlockStmt{}}
+	}
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "len bound is mutable", node: loop(token.LSS, &ast.CallExpr{Fun: ast.NewIdent("len")}), want: true},
+		{name: "integer bound is mutable", node: loop(token.GEQ, &ast.BasicLit{Kind: token.INT, Value: "0"}), want: true},
+		{name: "zero upper bound is not mutable", node: loop(token.LSS, &ast.BasicLit{Kind: token.INT, Value: "0"}), want: false},
+		{name: "identifier bound needs type information", node: loop(token.LSS, ast.NewIdent("n")), want: false},
+		{name: "equality is not mutable", node: loop(token.NEQ, &ast.BasicLit{Kind: token.INT, Value: "1"}), want: false},
+		{name: "loop without condition is not mutable", node: &ast.ForStmt{}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConditionBoundCanMutateWithType(t *testing.T) {
+	mut := ConditionBound{}
+	loops, info := testutil.Nodes[*ast.ForStmt](t, source)
+
+	tests := []struct {
+		name string
+		loop int
+		want bool
+	}{
+		{name: "len bound", loop: 0, want: true},
+		{name: "unsigned zero lower bound is raised", loop: 1, want: true},
+		{name: "unsigned zero upper bound can't be lowered", loop: 2, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutateWithType(loops[tc.loop], info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConditionBoundCanMutateWithTypeConstants(t *testing.T) {
+	mut := ConditionBound{}
+	loops, info := testutil.Nodes[*ast.ForStmt](t, `package sample
+
+func f() {
+	for i := uint8(255); i > 255; i-- {
+	}
+	for i := uint8(255); i > 254; i-- {
+	}
+	for i := int8(0); i < -128; i++ {
+	}
+	for i := 0; i < 1<<62; i++ {
+	}
+}
+`)
+
+	tests := []struct {
+		name string
+		loop int
+		want bool
+	}{
+		{name: "maximum uint8 bound can't be raised", loop: 0, want: false},
+		{name: "uint8 bound below the maximum is raised", loop: 1, want: true},
+		{name: "minimum int8 bound can't be lowered", loop: 2, want: false},
+		{name: "large int bound is raised", loop: 3, want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutateWithType(loops[tc.loop], info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConditionBoundMutate(t *testing.T) {
+	mut := ConditionBound{}
+	loops, _ := testutil.Nodes[*ast.ForStmt](t, source)
+
+	tests := []struct {
+		loop int
+		want string
+	}{
+		{loop: 0, want: "i < len(s) - 1"},
+		{loop: 1, want: "i > 0 + 1"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.want, func(t *testing.T) {
+			mutated := mut.Mutate(loops[tc.loop]).(*ast.ForStmt)
+			if got := types.ExprString(mutated.Cond); got != tc.want {
+				t.Fatalf("Mutate() condition = %s, want %s", got, tc.want)
+			}
+			if loops[tc.loop].Cond == mutated.Cond {
+				t.Fatalf("Mutate() modified the original condition")
+			}
+		})
+	}
+}
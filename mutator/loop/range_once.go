@@ -0,0 +1,69 @@
+package loop
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// RangeOnce turns range loops into single-iteration loops.
+// for _, v := range s { ... } -> for _, v := range s { ...; break }
+// Loops continued from their body are skipped, since continue would bypass the added break.
+type RangeOnce struct{}
+
+func (m RangeOnce) Name() string {
+	return "Loop_RANGE_ONCE"
+}
+
+func (m RangeOnce) CanMutate(node ast.Node) bool {
+	stmt, ok := node.(*ast.RangeStmt)
+	return ok && len(stmt.Body.List) > 0 && !continues(stmt.Body) && !endsLoop(stmt.Body)
+}
+
+func (m RangeOnce) Mutate(node ast.Node) ast.Node {
+	stmt := node.(*ast.RangeStmt)
+	body := *stmt.Body
+	body.List = append(append([]ast.Stmt(nil), stmt.Body.List...), &ast.BranchStmt{TokPos: stmt.Body.Rbrace, Tok: token.BREAK})
+	cloned := *stmt
+	cloned.Body = &body
+	return &cloned
+}
+
+// continues reports whether body contains a continue statement, outside of function literals,
+// that may target the loop it belongs to. Labelled continues in nested loops are assumed to.
+func continues(body *ast.BlockStmt) bool {
+	found := false
+	var walk func(root ast.Node, nested bool)
+	walk = func(root ast.Node, nested bool) {
+		ast.Inspect(root, func(n ast.Node) bool {
+			if found {
+				return false
+			}
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.ForStmt, *ast.RangeStmt:
+				if n != root {
+					walk(n, true)
+					return false
+				}
+			case *ast.BranchStmt:
+				if n.Tok == token.CONTINUE && (!nested || n.Label != nil) {
+					found = true
+				}
+			}
+			return true
+		})
+	}
+	walk(body, false)
+	return found
+}
+
+// endsLoop reports whether the body already ends in a break or another terminating statement.
+func endsLoop(body *ast.BlockStmt) bool {
+	last := body.List[len(body.List)-1]
+	if branch, ok := last.(*ast.BranchStmt); ok && branch.Tok == token.BREAK {
+		return true
+	}
+	_, ok := last.(*ast.ReturnStmt)
+	return ok
+}
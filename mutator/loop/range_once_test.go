@@ -0,0 +1,59 @@
+package loop
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestRangeOnceName(t *testing.T) {
+	mut := RangeOnce{}
+
+	if got, want := mut.Name(), "Loop_RANGE_ONCE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestRangeOnceCanMutate(t *testing.T) {
+	mut := RangeOnce{}
+	loops, _ := testutil.Nodes[*ast.RangeStmt](t, source)
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "loop continued from its body is not mutable", node: loops[1], want: false},
+		{name: "continue of a nested loop is ignored", node: loops[2], want: true},
+		{name: "labelled continue is not mutable", node: loops[0], want: false},
+		{name: "body ending in break is not mutable", node: &ast.RangeStmt{Body: &ast.BlockStmt{List: []ast.Stmt{&ast.BranchStmt{Tok: token.BREAK}}}}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRangeOnceMutate(t *testing.T) {
+	mut := RangeOnce{}
+	loops, _ := testutil.Nodes[*ast.RangeStmt](t, source)
+
+	original := loops[2]
+	mutated := mut.Mutate(original).(*ast.RangeStmt)
+
+	last := mutated.Body.List[len(mutated.Body.List)-1]
+	if branch, ok := last.(*ast.BranchStmt); !ok || branch.Tok != token.BREAK {
+		t.Fatalf("Mutate() body ends with %T, want break", last)
+	}
+	if len(original.Body.List) == len(mutated.Body.List) {
+		t.Fatalf("Mutate() modified the original body")
+	}
+}
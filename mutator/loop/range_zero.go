@@ -0,0 +1,48 @@
+package loop
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// RangeZero turns range loops into zero-iteration loops.
+// for _, v := range s { ... } -> for _, v := range s { break; ... }
+// The original body stays in place, unreachable, so every variable remains in use.
+// With type information channels and iterator functions are skipped: the break only follows
+// their first element, which is still received or produced, and receiving may block.
+type RangeZero struct{}
+
+func (m RangeZero) Name() string {
+	return "Loop_RANGE_ZERO"
+}
+
+func (m RangeZero) CanMutate(node ast.Node) bool {
+	stmt, ok := node.(*ast.RangeStmt)
+	return ok && len(stmt.Body.List) > 0
+}
+
+// CanMutateWithType additionally skips ranges over channels and functions.
+func (m RangeZero) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	t := typeInfo.TypeOf(node.(*ast.RangeStmt).X)
+	if t == nil {
+		return true
+	}
+	switch t.Underlying().(type) {
+	case *types.Chan, *types.Signature:
+		return false
+	}
+	return true
+}
+
+func (m RangeZero) Mutate(node ast.Node) ast.Node {
+	stmt := node.(*ast.RangeStmt)
+	body := *stmt.Body
+	body.List = append([]ast.Stmt{&ast.BranchStmt{TokPos: stmt.Body.Lbrace, Tok: token.BREAK}}, stmt.Body.List...)
+	cloned := *stmt
+	cloned.Body = &body
+	return &cloned
+}
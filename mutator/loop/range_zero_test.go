@@ -0,0 +1,100 @@
+package loop
+
+import (
+	"go/ast"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestRangeZeroName(t *testing.T) {
+	mut := RangeZero{}
+
+	if got, want := mut.Name(), "Loop_RANGE_ZERO"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestRangeZeroCanMutate(t *testing.T) {
+	mut := RangeZero{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "range loop is mutable", node: &ast.RangeStmt{Body: &ast.BlockStmt{List: []ast.Stmt{&ast.EmptyStmt{}}}}, want: true},
+		{name: "empty range loop is not mutable", node: &ast.RangeStmt{Body: &ast.BlockStmt{}}, want: false},
+		{name: "for loop is not mutable", node: &ast.ForStmt{Body: &ast.BlockStmt{}}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRangeZeroCanMutateWithType(t *testing.T) {
+	mut := RangeZero{}
+	loops, info := testutil.Nodes[*ast.RangeStmt](t, `package sample
+
+func f(s []int, m map[string]int, c chan int, seq func(func(int) bool)) {
+	for range s {
+		_ = 0
+	}
+	for range m {
+		_ = 0
+	}
+	for range 3 {
+		_ = 0
+	}
+	for range c {
+		_ = 0
+	}
+	for range seq {
+		_ = 0
+	}
+}
+`)
+
+	tests := []struct {
+		name string
+		loop int
+		want bool
+	}{
+		{name: "slice is mutable", loop: 0, want: true},
+		{name: "map is mutable", loop: 1, want: true},
+		{name: "integer is mutable", loop: 2, want: true},
+		{name: "channel is not mutable", loop: 3, want: false},
+		{name: "iterator function is not mutable", loop: 4, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutateWithType(loops[tc.loop], info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRangeZeroMutate(t *testing.T) {
+	mut := RangeZero{}
+	loops, _ := testutil.Nodes[*ast.RangeStmt](t, source)
+
+	original := loops[1]
+	mutated := mut.Mutate(original).(*ast.RangeStmt)
+
+	if len(mutated.Body.List) != len(original.Body.List)+1 {
+		t.Fatalf("Mutate() body has %d statements, want %d", len(mutated.Body.List), len(original.Body.List)+1)
+	}
+	if branch, ok := mutated.Body.List[0].(*ast.BranchStmt); !ok || branch.Tok != token.BREAK {
+		t.Fatalf("Mutate() body starts with %T, want break", mutated.Body.List[0])
+	}
+}
@@ -29,3 +29,30 @@ type TypeAwareMutator interface {
 	// Note that the CanMutate should still be implemented to handle cases where type info is not available.
 	CanMutateWithType(node ast.Node, typeInfo *types.Info) bool
 }
+
+// TypeAwareRewriter is an optional interface for mutators whose replacement node
+// depends on type information, e.g. to build zero values or keep variables used.
+// The runner calls MutateWithType instead of Mutate when type info is available.
+// Note that Mutate should still be implemented to handle cases where type info is not available.
+type TypeAwareRewriter interface {
+	Mutator
+	// MutateWithType applies the mutation to the node using type information and returns the mutated node
+	MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node
+}
+
+// ImportingMutator is an optional interface for mutators whose replacement may refer
+// to packages the mutated file doesn't import yet, e.g. errors.New.
+// The runner adds an import for each path whose package the replacement references.
+type ImportingMutator interface {
+	Mutator
+	// Imports returns the import paths the replacement may reference by their package name
+	Imports() []string
+}
+
+// RaceMutator is an optional interface for mutators whose mutants, such as a removed lock,
+// mostly show up as data races. When race detection is enabled the runner tests them with -race.
+type RaceMutator interface {
+	Mutator
+	// Race reports whether mutants should be tested with the race detector
+	Race() bool
+}
@@ -0,0 +1,97 @@
+package nil_handling
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// NilArgument replaces arguments of pointer, slice, map, interface, function and channel
+// types with nil.
+// f(p) -> f(nil)
+type NilArgument struct{}
+
+func (m NilArgument) Name() string {
+	return "Nil_ARGUMENT"
+}
+
+// CanMutate reports false: whether an argument can be nil depends on its type.
+func (m NilArgument) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType accepts non-nil arguments of nillable types. Arguments of builtins,
+// conversions and generic functions are skipped, as nil doesn't work there, and so are
+// arguments whose removal would leave a variable or import unused.
+func (m NilArgument) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	expr, ok := node.(ast.Expr)
+	if !ok || !nillable(typeInfo, expr) {
+		return false
+	}
+	file := typeutil.File(typeInfo, expr.Pos())
+	if file == nil {
+		return false
+	}
+	call, ok := astutil.Parent(file, expr).(*ast.CallExpr)
+	if !ok || call.Fun == expr || !acceptsNil(typeInfo, call) {
+		return false
+	}
+	if call.Ellipsis.IsValid() && call.Args[len(call.Args)-1] == expr {
+		return false
+	}
+	return len(typeutil.Unused(typeInfo, expr)) == 0
+}
+
+func (m NilArgument) Mutate(node ast.Node) ast.Node {
+	return &ast.Ident{NamePos: node.Pos(), Name: "nil"}
+}
+
+// nillable reports whether expr is a value, other than nil itself, of a type that has nil as its zero value.
+func nillable(typeInfo *types.Info, expr ast.Expr) bool {
+	if typeInfo == nil {
+		return false
+	}
+	tv, ok := typeInfo.Types[expr]
+	if !ok || !tv.IsValue() || tv.IsNil() {
+		return false
+	}
+	if _, ok := tv.Type.(*types.TypeParam); ok {
+		return false
+	}
+	switch u := tv.Type.Underlying().(type) {
+	case *types.Pointer, *types.Slice, *types.Map, *types.Signature, *types.Interface, *types.Chan:
+		return true
+	case *types.Basic:
+		return u.Kind() == types.UnsafePointer
+	}
+	return false
+}
+
+// acceptsNil reports whether nil can be passed to call's arguments: call must be a call of a
+// non-generic function or method, not a conversion or builtin.
+func acceptsNil(typeInfo *types.Info, call *ast.CallExpr) bool {
+	if tv, ok := typeInfo.Types[call.Fun]; !ok || tv.IsType() || tv.IsBuiltin() {
+		return false
+	}
+	var ident *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	case *ast.IndexExpr, *ast.IndexListExpr:
+		// explicitly instantiated generic function
+		return false
+	}
+	if ident == nil {
+		return true
+	}
+	fn, ok := typeInfo.Uses[ident].(*types.Func)
+	if !ok {
+		return true
+	}
+	sig, ok := fn.Type().(*types.Signature)
+	return ok && sig.TypeParams().Len() == 0 && sig.RecvTypeParams().Len() == 0
+}
@@ -0,0 +1,64 @@
+package nil_handling
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestNilArgumentName(t *testing.T) {
+	mut := NilArgument{}
+
+	if got, want := mut.Name(), "Nil_ARGUMENT"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestNilArgumentCanMutate(t *testing.T) {
+	mut := NilArgument{}
+
+	if mut.CanMutate(ast.NewIdent("p")) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestNilArgumentCanMutateWithType(t *testing.T) {
+	mut := NilArgument{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "pointer argument is mutable", text: "n.next, nil", want: true},
+		{name: "argument passed as interface is mutable", text: "n, local", want: true},
+		{name: "last argument is mutable", text: "local)\n", want: true},
+		{name: "argument only used here is not mutable", text: "single, nil", want: false},
+		{name: "nil argument is not mutable", text: "nil, n", want: false},
+		{name: "generic function argument is not mutable", text: "local)\n\tsingle", want: false},
+		{name: "builtin argument is not mutable", text: "m)\n\tfmt", want: false},
+		{name: "string argument is not mutable", text: "\"missing\")", want: false},
+		{name: "map argument is mutable", text: "m)\n\tif", want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[ast.Expr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(node), got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNilArgumentMutate(t *testing.T) {
+	mut := NilArgument{}
+
+	mutated, ok := mut.Mutate(&ast.Ident{NamePos: 6, Name: "p"}).(*ast.Ident)
+	if !ok || mutated.Name != "nil" || mutated.NamePos != 6 {
+		t.Fatalf("Mutate() = %#v, want nil at 6", mutated)
+	}
+}
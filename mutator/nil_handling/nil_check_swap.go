@@ -0,0 +1,58 @@
+package nil_handling
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// NilCheckSwap swaps the operator of comparisons with nil, independently of the generic
+// equality mutators.
+// x == nil -> x != nil
+// x != nil -> x == nil
+type NilCheckSwap struct{}
+
+func (m NilCheckSwap) Name() string {
+	return "Nil_CHECK_SWAP"
+}
+
+func (m NilCheckSwap) CanMutate(node ast.Node) bool {
+	bin, ok := node.(*ast.BinaryExpr)
+	if !ok || (bin.Op != token.EQL && bin.Op != token.NEQ) {
+		return false
+	}
+	return isNilIdent(bin.X) || isNilIdent(bin.Y)
+}
+
+// CanMutateWithType additionally checks that the nil operand is the predeclared nil,
+// not a shadowing declaration.
+func (m NilCheckSwap) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return nilComparison(node, typeInfo) != nil
+}
+
+func (m NilCheckSwap) Mutate(node ast.Node) ast.Node {
+	bin := node.(*ast.BinaryExpr)
+	op := token.EQL
+	if bin.Op == token.EQL {
+		op = token.NEQ
+	}
+	return &ast.BinaryExpr{X: bin.X, OpPos: bin.OpPos, Op: op, Y: bin.Y}
+}
+
+// isNilIdent reports whether expr is the identifier nil.
+func isNilIdent(expr ast.Expr) bool {
+	ident, ok := ast.Unparen(expr).(*ast.Ident)
+	return ok && ident.Name == "nil"
+}
+
+// nilComparison returns node if it is an == or != comparison of a value with the predeclared nil.
+func nilComparison(node ast.Node, typeInfo *types.Info) *ast.BinaryExpr {
+	bin, ok := node.(*ast.BinaryExpr)
+	if !ok || (bin.Op != token.EQL && bin.Op != token.NEQ) || typeInfo == nil {
+		return nil
+	}
+	if typeInfo.Types[bin.X].IsNil() || typeInfo.Types[bin.Y].IsNil() {
+		return bin
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package nil_handling
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+import (
+	"errors"
+	"fmt"
+)
+
+type node struct{ next *node }
+
+func use(p *node, s []int, args ...any) {}
+
+func generic[T any](v T) {}
+
+func find(m map[string]*node, k string) (*node, error) {
+	if v := m[k]; v == nil {
+		return nil, errors.New("missing")
+	}
+	local := m[k]
+	for _, n := range m {
+		if n == nil {
+			continue
+		}
+		use(n.next, nil, n, local)
+	}
+	generic(local)
+	single := m[k]
+	use(single, nil)
+	_ = len(m)
+	fmt.Println(m)
+	if local != nil {
+		return local, nil
+	}
+	if local == nil {
+		fmt.Println()
+	}
+	var err error
+	return m[k], err
+}
+
+func build() *node {
+	n := &node{}
+	return n
+}
+
+func shadow() bool {
+	nil := 0
+	return nil == 0
+}
+`
+
+func TestNilCheckSwapName(t *testing.T) {
+	mut := NilCheckSwap{}
+
+	if got, want := mut.Name(), "Nil_CHECK_SWAP"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestNilCheckSwapCanMutate(t *testing.T) {
+	mut := NilCheckSwap{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "equal to nil is mutable", node: &ast.BinaryExpr{X: ast.NewIdent("x"), Op: token.EQL, Y: ast.NewIdent("nil")}, want: true},
+		{name: "nil not equal to x is mutable", node: &ast.BinaryExpr{X: ast.NewIdent("nil"), Op: token.NEQ, Y: ast.NewIdent("x")}, want: true},
+		{name: "comparison without nil is not mutable", node: &ast.BinaryExpr{X: ast.NewIdent("x"), Op: token.EQL, Y: ast.NewIdent("y")}, want: false},
+		{name: "other operator is not mutable", node: &ast.BinaryExpr{X: ast.NewIdent("x"), Op: token.LSS, Y: ast.NewIdent("nil")}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNilCheckSwapCanMutateWithType(t *testing.T) {
+	mut := NilCheckSwap{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "comparison with nil is mutable", text: "local != nil", want: true},
+		{name: "comparison with shadowed nil is not mutable", text: "nil == 0", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.BinaryExpr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(node), got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNilCheckSwapMutate(t *testing.T) {
+	mut := NilCheckSwap{}
+
+	tests := []struct {
+		op   token.Token
+		want token.Token
+	}{
+		{op: token.EQL, want: token.NEQ},
+		{op: token.NEQ, want: token.EQL},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.op.String(), func(t *testing.T) {
+			original := &ast.BinaryExpr{X: ast.NewIdent("x"), OpPos: 4, Op: tc.op, Y: ast.NewIdent("nil")}
+			mutated := mut.Mutate(original).(*ast.BinaryExpr)
+
+			if mutated.Op != tc.want || mutated.OpPos != 4 {
+				t.Fatalf("Mutate() = %v at %d, want %v at 4", mutated.Op, mutated.OpPos, tc.want)
+			}
+			if original.Op != tc.op {
+				t.Fatalf("Mutate() modified the original node")
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package nil_handling
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// NilGuardRemoval removes nil guards: if statements without else that leave the function or
+// loop iteration when a value is nil.
+// if p == nil { return } -> (removed)
+// if v := m[k]; v == nil { continue } -> { v := m[k]; _ = v }
+type NilGuardRemoval struct{}
+
+func (m NilGuardRemoval) Name() string {
+	return "Nil_GUARD_REMOVE"
+}
+
+func (m NilGuardRemoval) CanMutate(node ast.Node) bool {
+	stmt, ok := node.(*ast.IfStmt)
+	if !ok {
+		return false
+	}
+	cond, ok := ast.Unparen(stmt.Cond).(*ast.BinaryExpr)
+	return ok && cond.Op == token.EQL && (isNilIdent(cond.X) || isNilIdent(cond.Y)) && isGuard(stmt)
+}
+
+// CanMutateWithType additionally checks that the nil operand is the predeclared nil.
+func (m NilGuardRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	stmt, ok := node.(*ast.IfStmt)
+	if !ok || !m.CanMutate(node) {
+		return false
+	}
+	return nilComparison(ast.Unparen(stmt.Cond), typeInfo) != nil && typeutil.Keepable(typeInfo, stmt.Cond, stmt.Body)
+}
+
+// Mutate removes the guard, keeping its init statement.
+func (m NilGuardRemoval) Mutate(node ast.Node) ast.Node {
+	stmt, ok := node.(*ast.IfStmt)
+	if !ok {
+		return node
+	}
+	return removeGuard(stmt, nil)
+}
+
+// MutateWithType removes the guard like Mutate, but keeps local variables and imported packages
+// only used by the guard in use with a blank assignment, so the mutant still compiles.
+func (m NilGuardRemoval) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	stmt, ok := node.(*ast.IfStmt)
+	if !ok || typeInfo == nil {
+		return m.Mutate(node)
+	}
+	return removeGuard(stmt, typeutil.BlankAssign(typeutil.Unused(typeInfo, stmt.Cond, stmt.Body)))
+}
+
+// isGuard reports whether stmt has no else branch and its body ends by leaving the function,
+// or the loop iteration with continue.
+func isGuard(stmt *ast.IfStmt) bool {
+	if stmt.Else != nil || len(stmt.Body.List) == 0 {
+		return false
+	}
+	last := stmt.Body.List[len(stmt.Body.List)-1]
+	if branch, ok := last.(*ast.BranchStmt); ok && branch.Tok == token.CONTINUE {
+		return true
+	}
+	return astutil.Terminates(last)
+}
+
+// removeGuard replaces stmt by its init statement and keep, in a block when there is more
+// than one of them or the init statement declares variables.
+func removeGuard(stmt *ast.IfStmt, keep ast.Stmt) ast.Stmt {
+	var list []ast.Stmt
+	for _, s := range []ast.Stmt{stmt.Init, keep} {
+		if s != nil {
+			list = append(list, s)
+		}
+	}
+	switch {
+	case len(list) == 0:
+		return &ast.EmptyStmt{Semicolon: stmt.Pos(), Implicit: true}
+	case len(list) == 1 && stmt.Init == nil:
+		return list[0]
+	}
+	return &ast.BlockStmt{Lbrace: stmt.Pos(), List: list, Rbrace: stmt.End()}
+}
@@ -0,0 +1,71 @@
+package nil_handling
+
+import (
+	"bytes"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestNilGuardRemovalName(t *testing.T) {
+	mut := NilGuardRemoval{}
+
+	if got, want := mut.Name(), "Nil_GUARD_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestNilGuardRemovalCanMutate(t *testing.T) {
+	mut := NilGuardRemoval{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "guard returning is mutable", text: "if v := m[k]", want: true},
+		{name: "guard continuing is mutable", text: "if n == nil", want: true},
+		{name: "not equal check is not mutable", text: "if local != nil", want: false},
+		{name: "check without leaving is not mutable", text: "if local == nil", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.IfStmt](t, source, tc.text)
+
+			if got := mut.CanMutate(node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNilGuardRemovalMutate(t *testing.T) {
+	mut := NilGuardRemoval{}
+	node, _ := testutil.Find[*ast.IfStmt](t, source, "if n == nil")
+
+	if _, ok := mut.Mutate(node).(*ast.EmptyStmt); !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.EmptyStmt", mut.Mutate(node))
+	}
+}
+
+func TestNilGuardRemovalMutateWithType(t *testing.T) {
+	mut := NilGuardRemoval{}
+	node, info := testutil.Find[*ast.IfStmt](t, source, "if v := m[k]")
+
+	mutated := mut.MutateWithType(node, info)
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), mutated); err != nil {
+		t.Fatalf("failed to print: %v", err)
+	}
+	if got, want := buf.String(), "{\n\tv := m[k]\n\t_, _ = v, errors.New\n}"; got != want {
+		t.Fatalf("MutateWithType() printed %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,64 @@
+package nil_handling
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// NilReturn replaces returned values of pointer, slice, map, interface, function and channel
+// types with nil. Errors are left to the error handling mutators.
+// return p, err -> return nil, err
+type NilReturn struct{}
+
+func (m NilReturn) Name() string {
+	return "Nil_RETURN"
+}
+
+// CanMutate reports false: whether a returned value can be nil depends on its type.
+func (m NilReturn) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType checks that node is a return statement with a non-nil result of a nillable type
+// whose replacement doesn't leave an import unused.
+func (m NilReturn) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.MutateWithType(node, typeInfo) != node
+}
+
+// Mutate returns the node unchanged, since nillable values can't be identified without type information.
+func (m NilReturn) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+func (m NilReturn) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	ret, ok := node.(*ast.ReturnStmt)
+	if !ok || typeInfo == nil {
+		return node
+	}
+
+	mutated := &ast.ReturnStmt{Return: ret.Return, Results: make([]ast.Expr, len(ret.Results))}
+	var replaced []ast.Node
+	for i, expr := range ret.Results {
+		mutated.Results[i] = expr
+		if nilResult(typeInfo, expr) {
+			mutated.Results[i] = &ast.Ident{NamePos: expr.Pos(), Name: "nil"}
+			replaced = append(replaced, expr)
+		}
+	}
+	if len(replaced) == 0 {
+		return node
+	}
+	// keep variables only used by the replaced results in use, e.g. `{ _ = p; return nil }`;
+	// a package only used through a type, as in &pkg.T{}, can't be
+	if kept := typeutil.KeepUsed(typeInfo, mutated, replaced...); kept != nil {
+		return kept
+	}
+	return node
+}
+
+// nilResult reports whether expr is a returned value that NilReturn replaces with nil.
+func nilResult(typeInfo *types.Info, expr ast.Expr) bool {
+	return nillable(typeInfo, expr) && !typeutil.IsError(typeInfo.TypeOf(expr))
+}
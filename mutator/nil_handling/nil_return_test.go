@@ -0,0 +1,80 @@
+package nil_handling
+
+import (
+	"bytes"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestNilReturnName(t *testing.T) {
+	mut := NilReturn{}
+
+	if got, want := mut.Name(), "Nil_RETURN"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestNilReturnCanMutate(t *testing.T) {
+	mut := NilReturn{}
+
+	if mut.CanMutate(&ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("p")}}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestNilReturnCanMutateWithType(t *testing.T) {
+	mut := NilReturn{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "returned pointer is mutable", text: "return local", want: true},
+		{name: "returned map element is mutable", text: "return m[k]", want: true},
+		{name: "nil and error results are not mutable", text: "return nil, errors", want: false},
+		{name: "non-nillable result is not mutable", text: "return nil == 0", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.ReturnStmt](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNilReturnMutateWithType(t *testing.T) {
+	mut := NilReturn{}
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "replaces nillable results", text: "return m[k]", want: "return nil, err"},
+		{name: "keeps variables only used by the result", text: "return n\n", want: "{\n\t_ = n\n\treturn nil\n}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.ReturnStmt](t, source, tc.text)
+			mutated := mut.MutateWithType(node, info)
+
+			var buf bytes.Buffer
+			if err := printer.Fprint(&buf, token.NewFileSet(), mutated); err != nil {
+				t.Fatalf("failed to print: %v", err)
+			}
+			if got := buf.String(); got != tc.want {
+				t.Fatalf("MutateWithType() printed %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
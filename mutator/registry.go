@@ -1,12 +1,29 @@
 package mutator
 
 import (
+	"fmt"
 	"go/ast"
+	"strings"
 
 	"github.com/renja-g/axiom/mutator/arithmetic"
 	"github.com/renja-g/axiom/mutator/boolean"
+	"github.com/renja-g/axiom/mutator/branch"
+	"github.com/renja-g/axiom/mutator/collection"
+	"github.com/renja-g/axiom/mutator/concurrency"
+	"github.com/renja-g/axiom/mutator/conditional"
 	"github.com/renja-g/axiom/mutator/conditional_boundary"
+	"github.com/renja-g/axiom/mutator/context_handling"
+	"github.com/renja-g/axiom/mutator/defer_handling"
+	"github.com/renja-g/axiom/mutator/error_handling"
+	"github.com/renja-g/axiom/mutator/function_call"
+	"github.com/renja-g/axiom/mutator/literal"
 	"github.com/renja-g/axiom/mutator/logical"
+	"github.com/renja-g/axiom/mutator/loop"
+	"github.com/renja-g/axiom/mutator/nil_handling"
+	"github.com/renja-g/axiom/mutator/return_value"
+	"github.com/renja-g/axiom/mutator/sign"
+	"github.com/renja-g/axiom/mutator/statement"
+	"github.com/renja-g/axiom/mutator/stdlib"
 )
 
 // Registry holds all available mutators
@@ -14,9 +31,27 @@ type Registry struct {
 	mutators []Mutator
 }
 
+// Options configures optional behaviour of the registered mutators.
+type Options struct {
+	// FormatStrings enables mutating the format strings of printf-style calls
+	FormatStrings bool
+	// Mutators limits the registry to mutators whose names start with one of these prefixes,
+	// e.g. "Nil_" or "Arithmetic_ADD". All mutators are registered when it is empty, and each
+	// prefix must match at least one mutator.
+	Mutators []string
+}
+
 // NewRegistry creates a new registry with all available mutators
 func NewRegistry() *Registry {
-	return &Registry{
+	// without prefixes no mutator selection can fail
+	registry, _ := NewRegistryWithOptions(Options{})
+	return registry
+}
+
+// NewRegistryWithOptions creates a new registry with all available mutators configured by opts.
+// It fails when one of the prefixes in opts.Mutators matches no mutator.
+func NewRegistryWithOptions(opts Options) (*Registry, error) {
+	registry := &Registry{
 		mutators: []Mutator{
 			// Conditional Boundary Mutators
 			conditional_boundary.EqualTo{},
@@ -26,11 +61,21 @@ func NewRegistry() *Registry {
 			conditional_boundary.GreaterThan{},
 			conditional_boundary.LessThan{},
 
+			// Conditional Mutators
+			conditional.ConditionalNegation{},
+			conditional.ConditionalTrue{},
+			conditional.ConditionalFalse{},
+
 			// Arithmetic Mutators
 			arithmetic.BitwiseAnd{},
 			arithmetic.BitwiseNot{},
 			arithmetic.BitwiseOr{},
 			arithmetic.BitwiseXor{},
+			arithmetic.AndNot{},
+			arithmetic.AndEqual{},
+			arithmetic.OrEqual{},
+			arithmetic.XorEqual{},
+			arithmetic.AndNotEqual{},
 			arithmetic.Increment{},
 			arithmetic.Decrement{},
 			arithmetic.DivEqual{},
@@ -46,17 +91,135 @@ func NewRegistry() *Registry {
 			arithmetic.IntegerLiteralBoundary{},
 			arithmetic.ShiftLeft{},
 			arithmetic.ShiftRight{},
+			arithmetic.ShiftLeftEqual{},
+			arithmetic.ShiftRightEqual{},
 
 			// Boolean Mutators
 			boolean.TrueValue{},
 			boolean.FalseValue{},
 
+			// Literal Mutators
+			literal.StringLiteral{FormatStrings: opts.FormatStrings},
+			literal.FloatZero{},
+			literal.FloatIncrement{},
+			literal.FloatNegate{},
+
+			// Sign Mutators
+			sign.MinusRemoval{},
+			sign.SignInversion{},
+			sign.LiteralSignFlip{},
+
 			// Logical Mutators
 			logical.LogicalAnd{},
 			logical.LogicalNot{},
 			logical.LogicalOr{},
+
+			// Statement Mutators
+			statement.StatementDeletion{},
+
+			// Branch Mutators
+			branch.IfBodyRemoval{},
+			branch.ElseBodyRemoval{},
+			branch.IfElseSwap{},
+			branch.CaseRemoval{},
+
+			// Error Handling Mutators
+			error_handling.ErrorCheckRemoval{},
+			error_handling.ErrorReturnNil{},
+			error_handling.ErrorUnwrap{},
+
+			// Loop Mutators
+			loop.BreakContinueSwap{},
+			loop.BranchRemoval{},
+			loop.ConditionBound{},
+			loop.RangeZero{},
+			loop.RangeOnce{},
+
+			// Return Value Mutators
+			return_value.ReturnZero{},
+			return_value.ReturnError{},
+
+			// Nil Mutators
+			nil_handling.NilCheckSwap{},
+			nil_handling.NilArgument{},
+			nil_handling.NilReturn{},
+			nil_handling.NilGuardRemoval{},
+
+			// Concurrency Mutators
+			concurrency.GoSync{},
+			concurrency.LockRemoval{},
+			concurrency.LockSwap{},
+			concurrency.WaitGroupRemoval{},
+			concurrency.CloseRemoval{},
+			concurrency.SelectDefaultRemoval{},
+
+			// Defer Mutators
+			defer_handling.DeferRemoval{},
+			defer_handling.DeferImmediate{},
+
+			// Context Mutators
+			context_handling.ContextBackground{},
+			context_handling.CancelRemoval{},
+
+			// Function Call Mutators
+			function_call.CallZero{},
+			function_call.ArgumentSwap{},
+			function_call.ArgumentZero{FormatStrings: opts.FormatStrings},
+
+			// Collection Mutators
+			collection.LenDecrement{},
+			collection.LenZero{},
+			collection.AppendRemoval{},
+			collection.SliceLow{},
+			collection.SliceHigh{},
+			collection.DeleteRemoval{},
+			collection.CopyRemoval{},
+			collection.MinMaxSwap{},
+			collection.ElementRemoval{},
+
+			// Standard Library Mutators
+			stdlib.CallSwap{},
+			stdlib.CallNegation{},
+			stdlib.SortInversion{},
 		},
 	}
+	var err error
+	if registry.mutators, err = selectMutators(opts.Mutators, registry.mutators); err != nil {
+		return nil, err
+	}
+	return registry, nil
+}
+
+// selectMutators returns the mutators whose names start with one of prefixes, or all of them
+// when there are no prefixes. Prefixes matching no mutator, likely misspelt, are an error.
+func selectMutators(prefixes []string, mutators []Mutator) ([]Mutator, error) {
+	if len(prefixes) == 0 {
+		return mutators, nil
+	}
+	var selected []Mutator
+	matched := make(map[string]bool)
+	for _, m := range mutators {
+		found := false
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(m.Name(), prefix) {
+				matched[prefix] = true
+				found = true
+			}
+		}
+		if found {
+			selected = append(selected, m)
+		}
+	}
+	var unmatched []string
+	for _, prefix := range prefixes {
+		if !matched[prefix] {
+			unmatched = append(unmatched, fmt.Sprintf("%q", prefix))
+		}
+	}
+	if len(unmatched) > 0 {
+		return nil, fmt.Errorf("no mutator matches %s", strings.Join(unmatched, ", "))
+	}
+	return selected, nil
 }
 
 // GetMutators returns all registered mutators
@@ -7,8 +7,23 @@ import (
 
 	"github.com/renja-g/axiom/mutator/arithmetic"
 	"github.com/renja-g/axiom/mutator/boolean"
+	"github.com/renja-g/axiom/mutator/branch"
+	"github.com/renja-g/axiom/mutator/collection"
+	"github.com/renja-g/axiom/mutator/concurrency"
+	"github.com/renja-g/axiom/mutator/conditional"
 	"github.com/renja-g/axiom/mutator/conditional_boundary"
+	"github.com/renja-g/axiom/mutator/context_handling"
+	"github.com/renja-g/axiom/mutator/defer_handling"
+	"github.com/renja-g/axiom/mutator/error_handling"
+	"github.com/renja-g/axiom/mutator/function_call"
+	"github.com/renja-g/axiom/mutator/literal"
 	"github.com/renja-g/axiom/mutator/logical"
+	"github.com/renja-g/axiom/mutator/loop"
+	"github.com/renja-g/axiom/mutator/nil_handling"
+	"github.com/renja-g/axiom/mutator/return_value"
+	"github.com/renja-g/axiom/mutator/sign"
+	"github.com/renja-g/axiom/mutator/statement"
+	"github.com/renja-g/axiom/mutator/stdlib"
 )
 
 func TestNewRegistryIncludesExpectedMutators(t *testing.T) {
@@ -26,12 +41,20 @@ func TestNewRegistryIncludesExpectedMutators(t *testing.T) {
 		conditional_boundary.LessThanOrEqualTo{},
 		conditional_boundary.GreaterThan{},
 		conditional_boundary.LessThan{},
+		conditional.ConditionalNegation{},
+		conditional.ConditionalTrue{},
+		conditional.ConditionalFalse{},
 		arithmetic.Increment{},
 		arithmetic.Decrement{},
 		arithmetic.BitwiseAnd{},
 		arithmetic.BitwiseNot{},
 		arithmetic.BitwiseOr{},
 		arithmetic.BitwiseXor{},
+		arithmetic.AndNot{},
+		arithmetic.AndEqual{},
+		arithmetic.OrEqual{},
+		arithmetic.XorEqual{},
+		arithmetic.AndNotEqual{},
 		arithmetic.DivEqual{},
 		arithmetic.Division{},
 		arithmetic.MinusEqual{},
@@ -45,11 +68,64 @@ func TestNewRegistryIncludesExpectedMutators(t *testing.T) {
 		arithmetic.IntegerLiteralBoundary{},
 		arithmetic.ShiftLeft{},
 		arithmetic.ShiftRight{},
+		arithmetic.ShiftLeftEqual{},
+		arithmetic.ShiftRightEqual{},
 		boolean.TrueValue{},
 		boolean.FalseValue{},
+		literal.StringLiteral{},
+		literal.FloatZero{},
+		literal.FloatIncrement{},
+		literal.FloatNegate{},
+		sign.MinusRemoval{},
+		sign.SignInversion{},
+		sign.LiteralSignFlip{},
 		logical.LogicalAnd{},
 		logical.LogicalNot{},
 		logical.LogicalOr{},
+		statement.StatementDeletion{},
+		branch.IfBodyRemoval{},
+		branch.ElseBodyRemoval{},
+		branch.IfElseSwap{},
+		branch.CaseRemoval{},
+		error_handling.ErrorCheckRemoval{},
+		error_handling.ErrorReturnNil{},
+		error_handling.ErrorUnwrap{},
+		loop.BreakContinueSwap{},
+		loop.BranchRemoval{},
+		loop.ConditionBound{},
+		loop.RangeZero{},
+		loop.RangeOnce{},
+		return_value.ReturnZero{},
+		return_value.ReturnError{},
+		nil_handling.NilCheckSwap{},
+		nil_handling.NilArgument{},
+		nil_handling.NilReturn{},
+		nil_handling.NilGuardRemoval{},
+		concurrency.GoSync{},
+		concurrency.LockRemoval{},
+		concurrency.LockSwap{},
+		concurrency.WaitGroupRemoval{},
+		concurrency.CloseRemoval{},
+		concurrency.SelectDefaultRemoval{},
+		defer_handling.DeferRemoval{},
+		defer_handling.DeferImmediate{},
+		context_handling.ContextBackground{},
+		context_handling.CancelRemoval{},
+		function_call.CallZero{},
+		function_call.ArgumentSwap{},
+		function_call.ArgumentZero{},
+		collection.LenDecrement{},
+		collection.LenZero{},
+		collection.AppendRemoval{},
+		collection.SliceLow{},
+		collection.SliceHigh{},
+		collection.DeleteRemoval{},
+		collection.CopyRemoval{},
+		collection.MinMaxSwap{},
+		collection.ElementRemoval{},
+		stdlib.CallSwap{},
+		stdlib.CallNegation{},
+		stdlib.SortInversion{},
 	}
 
 	for _, mut := range expected {
@@ -60,6 +136,60 @@ func TestNewRegistryIncludesExpectedMutators(t *testing.T) {
 	}
 }
 
+func TestNewRegistryWithOptionsConfiguresMutators(t *testing.T) {
+	for _, formatStrings := range []bool{false, true} {
+		registry, err := NewRegistryWithOptions(Options{FormatStrings: formatStrings})
+		if err != nil {
+			t.Fatalf("NewRegistryWithOptions returned error: %v", err)
+		}
+
+		found := 0
+		for _, m := range registry.GetMutators() {
+			switch m := m.(type) {
+			case literal.StringLiteral:
+				found++
+				if m.FormatStrings != formatStrings {
+					t.Fatalf("StringLiteral.FormatStrings = %v, want %v", m.FormatStrings, formatStrings)
+				}
+			case function_call.ArgumentZero:
+				found++
+				if m.FormatStrings != formatStrings {
+					t.Fatalf("ArgumentZero.FormatStrings = %v, want %v", m.FormatStrings, formatStrings)
+				}
+			}
+		}
+		if found != 2 {
+			t.Fatalf("expected StringLiteral and ArgumentZero to be registered")
+		}
+	}
+}
+
+func TestNewRegistryWithOptionsSelectsMutators(t *testing.T) {
+	registry, err := NewRegistryWithOptions(Options{Mutators: []string{"Nil_", "Arithmetic_ADD"}})
+	if err != nil {
+		t.Fatalf("NewRegistryWithOptions returned error: %v", err)
+	}
+
+	var names []string
+	for _, m := range registry.GetMutators() {
+		names = append(names, m.Name())
+	}
+	want := []string{"Arithmetic_ADD_ASSIGN", "Arithmetic_ADD", "Nil_CHECK_SWAP", "Nil_ARGUMENT", "Nil_RETURN", "Nil_GUARD_REMOVE"}
+	if !reflect.DeepEqual(names, want) {
+		t.Fatalf("selected mutators = %v, want %v", names, want)
+	}
+}
+
+func TestNewRegistryWithOptionsRejectsUnmatchedPrefixes(t *testing.T) {
+	_, err := NewRegistryWithOptions(Options{Mutators: []string{"Nil_", "Nill_", "Arithmetic_ADD", "arithmetic_"}})
+	if err == nil {
+		t.Fatalf("expected an error for prefixes matching no mutator")
+	}
+	if got, want := err.Error(), `no mutator matches "Nill_", "arithmetic_"`; got != want {
+		t.Fatalf("error = %q, want %q", got, want)
+	}
+}
+
 type mockMutator struct {
 	name      string
 	canMutate bool
@@ -0,0 +1,82 @@
+package return_value
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ReturnError swaps success and failure in functions returning an error last
+// return v, nil -> return 0, errors.New("axiom")
+// return v, fmt.Errorf("...") -> return v, nil
+// Returned error variables (return v, err) are mutated by Error_RETURN_NIL instead.
+type ReturnError struct{}
+
+func (m ReturnError) Name() string {
+	return "Return_ERROR"
+}
+
+// CanMutate reports false: the error result is only known from type information.
+func (m ReturnError) CanMutate(node ast.Node) bool {
+	return false
+}
+
+func (m ReturnError) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	return m.MutateWithType(node, typeInfo) != node
+}
+
+// Mutate returns the node unchanged, since the error result can't be identified without type information.
+func (m ReturnError) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+func (m ReturnError) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	ret, results := returnResults(node, typeInfo)
+	if ret == nil {
+		return node
+	}
+	last := results.Len() - 1
+	if !typeutil.IsError(results.At(last).Type()) {
+		return node
+	}
+
+	mutated := &ast.ReturnStmt{Return: ret.Return, Results: make([]ast.Expr, len(ret.Results))}
+	copy(mutated.Results, ret.Results)
+
+	if !typeInfo.Types[ret.Results[last]].IsNil() {
+		// failure -> success; returned error variables are left to Error_RETURN_NIL
+		if _, ok := ast.Unparen(ret.Results[last]).(*ast.Ident); ok {
+			return node
+		}
+		mutated.Results[last] = ast.NewIdent("nil")
+		return keepUsed(typeInfo, node, mutated, ret.Results[last])
+	}
+
+	// success -> failure, with zero values for the other results
+	var replaced []ast.Node
+	for i := 0; i < last; i++ {
+		zero := typeutil.ZeroValue(typeInfo, ret.Pos(), results.At(i).Type())
+		if zero == nil {
+			return node
+		}
+		mutated.Results[i] = zero
+		replaced = append(replaced, ret.Results[i])
+	}
+	errorsName, _, ok := typeutil.PackageName(typeInfo, ret.Pos(), "errors", "errors")
+	if !ok {
+		return node
+	}
+	mutated.Results[last] = &ast.CallExpr{
+		Fun:  &ast.SelectorExpr{X: ast.NewIdent(errorsName), Sel: ast.NewIdent("New")},
+		Args: []ast.Expr{&ast.BasicLit{Kind: token.STRING, Value: strconv.Quote("axiom")}},
+	}
+	return keepUsed(typeInfo, node, mutated, replaced...)
+}
+
+// Imports returns the errors package, which the file may need to import for errors.New.
+func (m ReturnError) Imports() []string {
+	return []string{"errors"}
+}
@@ -0,0 +1,65 @@
+package return_value
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestReturnErrorName(t *testing.T) {
+	mut := ReturnError{}
+
+	if got, want := mut.Name(), "Return_ERROR"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestReturnErrorCanMutate(t *testing.T) {
+	mut := ReturnError{}
+
+	if mut.CanMutate(&ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("nil")}}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestReturnErrorMutateWithType(t *testing.T) {
+	mut := ReturnError{}
+	rets, info := testutil.Nodes[*ast.ReturnStmt](t, source)
+
+	tests := []struct {
+		name string
+		ret  int
+		want string // empty when the statement can't be mutated
+	}{
+		{name: "no error result", ret: 0, want: ""},
+		{name: "success becomes failure", ret: 2, want: `return nil, errors.New("axiom")`},
+		{name: "failure becomes success keeping the package used", ret: 3, want: "{\n\t_ = errors.New\n\treturn point{1, 2}, time.Second, nil\n}"},
+		{name: "multi-value call", ret: 8, want: ""},
+		{name: "errors shadowed by a local", ret: 10, want: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ret := rets[tc.ret]
+
+			if got := mut.CanMutateWithType(ret, info); got != (tc.want != "") {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want != "")
+			}
+			if tc.want == "" {
+				return
+			}
+			if got := printNode(t, mut.MutateWithType(ret, info)); got != tc.want {
+				t.Fatalf("MutateWithType() printed %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReturnErrorImports(t *testing.T) {
+	mut := ReturnError{}
+
+	if got := mut.Imports(); len(got) != 1 || got[0] != "errors" {
+		t.Fatalf("Imports() = %v, want [errors]", got)
+	}
+}
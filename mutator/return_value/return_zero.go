@@ -0,0 +1,96 @@
+package return_value
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// ReturnZero mutates returned values to the zero value of the function's result types
+// return x, y -> return 0, nil
+// In functions returning an error last, the error is kept; Return_ERROR and Error_RETURN_NIL mutate it.
+type ReturnZero struct{}
+
+func (m ReturnZero) Name() string {
+	return "Return_ZERO"
+}
+
+// CanMutate reports false: zero values depend on the result types, so the mutation
+// is only discovered when type information is available.
+func (m ReturnZero) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType checks that every result can be replaced by its zero value and that
+// at least one result isn't a zero value already.
+func (m ReturnZero) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	ret, results := returnResults(node, typeInfo)
+	if ret == nil {
+		return false
+	}
+
+	changed := false
+	for i, expr := range ret.Results {
+		if i == results.Len()-1 && typeutil.IsError(results.At(i).Type()) {
+			continue
+		}
+		if typeutil.IsZero(typeInfo, expr) {
+			continue
+		}
+		if typeutil.ZeroValue(typeInfo, ret.Pos(), results.At(i).Type()) == nil {
+			return false
+		}
+		changed = true
+	}
+	return changed && m.MutateWithType(node, typeInfo) != node
+}
+
+// Mutate returns the node unchanged, since zero values can't be built without type information.
+func (m ReturnZero) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+func (m ReturnZero) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	ret, results := returnResults(node, typeInfo)
+	if ret == nil {
+		return node
+	}
+
+	mutated := &ast.ReturnStmt{Return: ret.Return, Results: make([]ast.Expr, len(ret.Results))}
+	var replaced []ast.Node
+	for i, expr := range ret.Results {
+		mutated.Results[i] = expr
+		if i == results.Len()-1 && typeutil.IsError(results.At(i).Type()) {
+			continue
+		}
+		if zero := typeutil.ZeroValue(typeInfo, ret.Pos(), results.At(i).Type()); zero != nil {
+			mutated.Results[i] = zero
+			replaced = append(replaced, expr)
+		}
+	}
+	// keep variables only used by the replaced results in use, e.g. `{ _ = x; return 0 }`
+	return keepUsed(typeInfo, node, mutated, replaced...)
+}
+
+// keepUsed returns mutated kept compilable by typeutil.KeepUsed, or node when that's impossible.
+func keepUsed(typeInfo *types.Info, node ast.Node, mutated ast.Stmt, replaced ...ast.Node) ast.Node {
+	if kept := typeutil.KeepUsed(typeInfo, mutated, replaced...); kept != nil {
+		return kept
+	}
+	return node
+}
+
+// returnResults returns the return statement and the enclosing function's result types
+// when the statement lists one expression per result.
+func returnResults(node ast.Node, typeInfo *types.Info) (*ast.ReturnStmt, *types.Tuple) {
+	ret, ok := node.(*ast.ReturnStmt)
+	if !ok || len(ret.Results) == 0 || typeInfo == nil {
+		return nil, nil
+	}
+	sig := typeutil.EnclosingSignature(typeInfo, ret.Pos())
+	if sig == nil || sig.Results().Len() != len(ret.Results) {
+		return nil, nil
+	}
+	return ret, sig.Results()
+}
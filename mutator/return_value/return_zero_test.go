@@ -0,0 +1,114 @@
+package return_value
+
+import (
+	"bytes"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+import (
+	"errors"
+	"time"
+)
+
+type point struct{ x, y int }
+
+func count() int { return 42 }
+
+func name() (string, bool) { return "n", true }
+
+func parse() (*point, error) { return &point{}, nil }
+
+func fail() (point, time.Duration, error) { return point{1, 2}, time.Second, errors.New("x") }
+
+func zero() (int, error) { return 0, nil }
+
+func generic[T any](v T) T { return v }
+
+func closure() func() []int {
+	return func() []int { return []int{1} }
+}
+
+func call() (int, error) { return multi() }
+
+func multi() (int, error) { return 1, nil }
+
+func local() (int, error) {
+	errors := 1
+	return errors, nil
+}
+
+func compute() int {
+	n := count()
+	return n
+}
+`
+
+func printNode(t *testing.T, node ast.Node) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), node); err != nil {
+		t.Fatalf("failed to print: %v", err)
+	}
+	return buf.String()
+}
+
+func TestReturnZeroName(t *testing.T) {
+	mut := ReturnZero{}
+
+	if got, want := mut.Name(), "Return_ZERO"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestReturnZeroCanMutate(t *testing.T) {
+	mut := ReturnZero{}
+
+	if mut.CanMutate(&ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("x")}}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestReturnZeroMutateWithType(t *testing.T) {
+	mut := ReturnZero{}
+	rets, info := testutil.Nodes[*ast.ReturnStmt](t, source)
+
+	tests := []struct {
+		name string
+		ret  int
+		want string // empty when the statement can't be mutated
+	}{
+		{name: "int", ret: 0, want: "return 0"},
+		{name: "string and bool", ret: 1, want: `return "", false`},
+		{name: "pointer keeps error", ret: 2, want: "return nil, nil"},
+		{name: "struct and named duration", ret: 3, want: `return point{}, 0, errors.New("x")`},
+		{name: "already zero", ret: 4, want: ""},
+		{name: "type parameter", ret: 5, want: "return *new(T)"},
+		{name: "function literal", ret: 6, want: "return nil"},
+		{name: "slice in function literal", ret: 7, want: "return nil"},
+		{name: "multi-value call", ret: 8, want: ""},
+		{name: "keeps local only used by the result", ret: 11, want: "{\n\t_ = n\n\treturn 0\n}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ret := rets[tc.ret]
+
+			if got := mut.CanMutateWithType(ret, info); got != (tc.want != "") {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want != "")
+			}
+			if tc.want == "" {
+				return
+			}
+			if got := printNode(t, mut.MutateWithType(ret, info)); got != tc.want {
+				t.Fatalf("MutateWithType() printed %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
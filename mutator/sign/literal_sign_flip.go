@@ -0,0 +1,71 @@
+package sign
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// LiteralSignFlip negates non-zero integer literals of signed types. Negative literals are
+// flipped back by MinusRemoval and float literals by Literal_FLOAT_NEGATE.
+// 5 -> -5
+type LiteralSignFlip struct{}
+
+func (m LiteralSignFlip) Name() string {
+	return "Sign_LITERAL_FLIP"
+}
+
+// CanMutate reports false: only type information tells whether the literal's type is signed.
+func (m LiteralSignFlip) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType accepts integer literals converted to a signed type, outside of positions
+// that must be non-negative constants, such as array lengths, indices and shift counts.
+// Literals in constant declarations are skipped since the constant's uses aren't checked.
+func (m LiteralSignFlip) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	lit, ok := node.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return false
+	}
+	tv, ok := typeInfo.Types[lit]
+	if !ok || tv.Value == nil || constant.Sign(tv.Value) == 0 || !isSigned(tv.Type) {
+		return false
+	}
+	if inConstDecl(typeInfo, lit) {
+		return false
+	}
+	value := constant.UnaryOp(token.SUB, tv.Value, 0)
+	return negatable(typeInfo, lit) && typeutil.Representable(value, tv.Type) && !typeutil.DuplicatesConstant(typeInfo, lit, value)
+}
+
+func (m LiteralSignFlip) Mutate(node ast.Node) ast.Node {
+	lit := node.(*ast.BasicLit)
+	return &ast.UnaryExpr{
+		OpPos: lit.ValuePos,
+		Op:    token.SUB,
+		X:     &ast.BasicLit{ValuePos: lit.ValuePos, Kind: lit.Kind, Value: lit.Value},
+	}
+}
+
+// inConstDecl reports whether expr is part of a constant declaration.
+func inConstDecl(typeInfo *types.Info, expr ast.Expr) bool {
+	file := typeutil.File(typeInfo, expr.Pos())
+	if file == nil {
+		return false
+	}
+	found := false
+	ast.Inspect(file, func(n ast.Node) bool {
+		if found || n == nil || expr.Pos() < n.Pos() || expr.Pos() >= n.End() {
+			return false
+		}
+		if decl, ok := n.(*ast.GenDecl); ok && decl.Tok == token.CONST {
+			found = true
+		}
+		return true
+	})
+	return found
+}
@@ -0,0 +1,72 @@
+package sign
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestLiteralSignFlipName(t *testing.T) {
+	mut := LiteralSignFlip{}
+
+	if got, want := mut.Name(), "Sign_LITERAL_FLIP"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestLiteralSignFlipCanMutate(t *testing.T) {
+	mut := LiteralSignFlip{}
+
+	if mut.CanMutate(&ast.BasicLit{Kind: token.INT, Value: "5"}) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestLiteralSignFlipCanMutateWithType(t *testing.T) {
+	mut := LiteralSignFlip{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "signed literal is mutable", text: "7}", want: true},
+		{name: "case not duplicating another case is mutable", text: "2, -3", want: true},
+		{name: "case duplicating another case is not mutable", text: "1, -1", want: false},
+		{name: "already negated literal is not mutable", text: "5\n", want: false},
+		{name: "constant declaration is not mutable", text: "4\n", want: false},
+		{name: "array length is not mutable", text: "2]int", want: false},
+		{name: "index is not mutable", text: "1] +", want: false},
+		{name: "shift count is not mutable", text: "2 +", want: false},
+		{name: "make size is not mutable", text: "2))", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.BasicLit](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(node), got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLiteralSignFlipMutate(t *testing.T) {
+	mut := LiteralSignFlip{}
+	original := &ast.BasicLit{ValuePos: 9, Kind: token.INT, Value: "5"}
+
+	mutated, ok := mut.Mutate(original).(*ast.UnaryExpr)
+	if !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.UnaryExpr", mutated)
+	}
+	if got := types.ExprString(mutated); got != "-5" || mutated.OpPos != 9 {
+		t.Fatalf("Mutate() = %s at %d, want -5 at 9", got, mutated.OpPos)
+	}
+	if mutated.X == original {
+		t.Fatalf("Mutate() reused the original literal")
+	}
+}
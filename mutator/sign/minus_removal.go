@@ -0,0 +1,84 @@
+package sign
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// MinusRemoval removes unary minus.
+// -x -> x
+type MinusRemoval struct{}
+
+func (m MinusRemoval) Name() string {
+	return "Sign_MINUS_REMOVE"
+}
+
+func (m MinusRemoval) CanMutate(node ast.Node) bool {
+	unary, ok := node.(*ast.UnaryExpr)
+	return ok && unary.Op == token.SUB
+}
+
+// CanMutateWithType additionally skips constants whose positive value overflows their type,
+// as in -128 used as an int8, or duplicates another switch case or map key.
+func (m MinusRemoval) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) {
+		return false
+	}
+	tv, ok := typeInfo.Types[node.(ast.Expr)]
+	if !ok || tv.Value == nil {
+		return true
+	}
+	value := constant.UnaryOp(token.SUB, tv.Value, 0)
+	return typeutil.Representable(value, tv.Type) && !typeutil.DuplicatesConstant(typeInfo, node.(ast.Expr), value)
+}
+
+func (m MinusRemoval) Mutate(node ast.Node) ast.Node {
+	unary := node.(*ast.UnaryExpr)
+	return unary.X
+}
+
+// isSigned reports whether t is a typed numeric type that can hold negative values.
+func isSigned(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsNumeric != 0 && basic.Info()&(types.IsUnsigned|types.IsUntyped) == 0
+}
+
+// negatable reports whether expr, a value of signed type, can be negated where it appears. It
+// isn't when expr is assigned to, has its address taken, is already negated, is a statement on its
+// own or must be a non-negative constant, like an array length, index or shift count.
+func negatable(typeInfo *types.Info, expr ast.Expr) bool {
+	file := typeutil.File(typeInfo, expr.Pos())
+	if file == nil {
+		return false
+	}
+	switch p := astutil.Parent(file, expr).(type) {
+	case *ast.AssignStmt:
+		for _, lhs := range p.Lhs {
+			if lhs == expr {
+				return false
+			}
+		}
+		return !(p.Tok == token.SHL_ASSIGN || p.Tok == token.SHR_ASSIGN)
+	case *ast.IncDecStmt, *ast.RangeStmt, *ast.ExprStmt, *ast.GoStmt, *ast.DeferStmt, *ast.ArrayType, *ast.SliceExpr:
+		return false
+	case *ast.UnaryExpr:
+		return p.Op != token.AND && p.Op != token.SUB && p.Op != token.ADD
+	case *ast.IndexExpr:
+		return p.Index != expr
+	case *ast.BinaryExpr:
+		return p.Y != expr || (p.Op != token.SHL && p.Op != token.SHR)
+	case *ast.CallExpr:
+		ident, ok := ast.Unparen(p.Fun).(*ast.Ident)
+		if !ok {
+			return true
+		}
+		builtin, ok := typeInfo.Uses[ident].(*types.Builtin)
+		return !ok || builtin.Name() != "make"
+	}
+	return true
+}
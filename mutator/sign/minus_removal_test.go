@@ -0,0 +1,101 @@
+package sign
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+const typed int = 4
+
+type item struct{ price int }
+
+func g() int { return 1 }
+
+func f(x int, u uint, fl float64, s []int) int {
+	var a [2]int
+	var b int8 = -128
+	x = -x
+	x++
+	y := -5
+	p := &x
+	it := item{price: 7}
+	switch x {
+	case 1, -1:
+	case 2, -3:
+	}
+	g()
+	z := s[1] + a[0] + x<<2 + len(make([]int, 2))
+	return x + g() + int(fl) + int(u) + y + typed + it.price + int(b) + *p + z
+}
+`
+
+func TestMinusRemovalName(t *testing.T) {
+	mut := MinusRemoval{}
+
+	if got, want := mut.Name(), "Sign_MINUS_REMOVE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestMinusRemovalCanMutate(t *testing.T) {
+	mut := MinusRemoval{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{name: "unary minus is mutable", node: &ast.UnaryExpr{Op: token.SUB, X: ast.NewIdent("x")}, want: true},
+		{name: "unary plus is not mutable", node: &ast.UnaryExpr{Op: token.ADD, X: ast.NewIdent("x")}, want: false},
+		{name: "binary minus is not mutable", node: &ast.BinaryExpr{Op: token.SUB}, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := mut.CanMutate(tc.node); got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMinusRemovalCanMutateWithType(t *testing.T) {
+	mut := MinusRemoval{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "negated variable is mutable", text: "-x", want: true},
+		{name: "negative literal is mutable", text: "-5", want: true},
+		{name: "overflowing constant is not mutable", text: "-128", want: false},
+		{name: "case duplicating another case is not mutable", text: "-1:", want: false},
+		{name: "case not duplicating another case is mutable", text: "-3:", want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.UnaryExpr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(node), got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMinusRemovalMutate(t *testing.T) {
+	mut := MinusRemoval{}
+	x := ast.NewIdent("x")
+
+	if got := mut.Mutate(&ast.UnaryExpr{Op: token.SUB, X: x}); got != x {
+		t.Fatalf("Mutate() = %#v, want operand", got)
+	}
+}
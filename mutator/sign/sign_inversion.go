@@ -0,0 +1,43 @@
+package sign
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// SignInversion negates variables and call results of signed numeric types.
+// x -> -x
+// f() -> -f()
+type SignInversion struct{}
+
+func (m SignInversion) Name() string {
+	return "Sign_INVERT"
+}
+
+// CanMutate reports false: whether an identifier or call is a signed number, and not a
+// type, function or constant, can only be told with type information.
+func (m SignInversion) CanMutate(node ast.Node) bool {
+	return false
+}
+
+// CanMutateWithType accepts identifiers and calls whose value is a signed number. Constants
+// are left to the literal mutators, since negating them can make constant expressions invalid.
+func (m SignInversion) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	switch node.(type) {
+	case *ast.Ident, *ast.CallExpr:
+	default:
+		return false
+	}
+	expr := node.(ast.Expr)
+	tv, ok := typeInfo.Types[expr]
+	if !ok || !tv.IsValue() || tv.Value != nil || !isSigned(tv.Type) {
+		return false
+	}
+	return negatable(typeInfo, expr)
+}
+
+func (m SignInversion) Mutate(node ast.Node) ast.Node {
+	expr := node.(ast.Expr)
+	return &ast.UnaryExpr{OpPos: expr.Pos(), Op: token.SUB, X: expr}
+}
@@ -0,0 +1,80 @@
+package sign
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestSignInversionName(t *testing.T) {
+	mut := SignInversion{}
+
+	if got, want := mut.Name(), "Sign_INVERT"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestSignInversionCanMutate(t *testing.T) {
+	mut := SignInversion{}
+
+	if mut.CanMutate(ast.NewIdent("x")) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestSignInversionCanMutateWithType(t *testing.T) {
+	mut := SignInversion{}
+
+	tests := []struct {
+		name string
+		text string
+		call bool
+		want bool
+	}{
+		{name: "signed variable is mutable", text: "x + g()", want: true},
+		{name: "call result is mutable", text: "g() + int", call: true, want: true},
+		{name: "conversion result is mutable", text: "int(fl)", call: true, want: true},
+		{name: "float variable is mutable", text: "fl) +", want: true},
+		{name: "unsigned variable is not mutable", text: "u) +", want: false},
+		{name: "constant is not mutable", text: "typed +", want: false},
+		{name: "assigned variable is not mutable", text: "x = -x", want: false},
+		{name: "negated variable is not mutable", text: "x\n\tx++", want: false},
+		{name: "incremented variable is not mutable", text: "x++", want: false},
+		{name: "address operand is not mutable", text: "x\n\tit", want: false},
+		{name: "call statement is not mutable", text: "g()\n", call: true, want: false},
+		{name: "conversion type is not mutable", text: "int(u)", want: false},
+		{name: "function is not mutable", text: "g() +", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var node ast.Expr
+			var info *types.Info
+			if tc.call {
+				node, info = testutil.Find[*ast.CallExpr](t, source, tc.text)
+			} else {
+				node, info = testutil.Find[*ast.Ident](t, source, tc.text)
+			}
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType(%s) = %v, want %v", types.ExprString(node), got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSignInversionMutate(t *testing.T) {
+	mut := SignInversion{}
+	x := &ast.Ident{NamePos: 9, Name: "x"}
+
+	mutated, ok := mut.Mutate(x).(*ast.UnaryExpr)
+	if !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.UnaryExpr", mutated)
+	}
+	if mutated.Op != token.SUB || mutated.X != x || mutated.OpPos != 9 {
+		t.Fatalf("Mutate() = %s at %d, want -x at 9", types.ExprString(mutated), mutated.OpPos)
+	}
+}
@@ -0,0 +1,81 @@
+package statement
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// StatementDeletion removes statements with side effects.
+// f(x); x = y; x += y; x++; go f(); ch <- v -> (removed)
+// Declarations (x := y) are kept since removing them leaves later uses undefined, and so is
+// the x.(type) guard of a type switch, which can't be removed.
+// Deferred calls and calls of close, copy and delete are removed by dedicated mutators instead.
+// With type information, the init and post statements of a for clause are kept too, since
+// removing i++ from for i := 0; i < n; i++ makes the loop infinite.
+type StatementDeletion struct{}
+
+func (m StatementDeletion) Name() string {
+	return "Statement_DELETE"
+}
+
+func (m StatementDeletion) CanMutate(node ast.Node) bool {
+	switch stmt := node.(type) {
+	case *ast.ExprStmt:
+		if assert, ok := stmt.X.(*ast.TypeAssertExpr); ok && assert.Type == nil {
+			// the guard of switch x.(type)
+			return false
+		}
+		if call, ok := stmt.X.(*ast.CallExpr); ok {
+			if ident, ok := call.Fun.(*ast.Ident); ok {
+				switch ident.Name {
+				case "panic":
+					// panic is a terminating statement; removing it can leave a function without a return
+					return false
+				case "close", "copy", "delete":
+					// removed by Concurrency_CLOSE_REMOVE and the collection mutators
+					return false
+				}
+			}
+		}
+		return true
+	case *ast.AssignStmt:
+		return stmt.Tok != token.DEFINE
+	case *ast.IncDecStmt, *ast.GoStmt, *ast.SendStmt:
+		return true
+	}
+	return false
+}
+
+// CanMutateWithType additionally skips the init and post statements of for loops.
+func (m StatementDeletion) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	if !m.CanMutate(node) || !typeutil.Keepable(typeInfo, node) {
+		return false
+	}
+	file := typeutil.File(typeInfo, node.Pos())
+	if file == nil {
+		return true
+	}
+	loop, ok := astutil.Parent(file, node).(*ast.ForStmt)
+	return !ok || (loop.Init != node && loop.Post != node)
+}
+
+func (m StatementDeletion) Mutate(node ast.Node) ast.Node {
+	return &ast.EmptyStmt{Semicolon: node.Pos(), Implicit: true}
+}
+
+// MutateWithType removes the statement like Mutate, but keeps local variables and imported
+// packages that are only referenced by the removed statement in use with a blank assignment
+// (`_ = x`), so the mutant still compiles.
+func (m StatementDeletion) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	if typeInfo == nil {
+		return m.Mutate(node)
+	}
+	if assign := typeutil.BlankAssign(typeutil.Unused(typeInfo, node)); assign != nil {
+		return assign
+	}
+	return m.Mutate(node)
+}
@@ -0,0 +1,209 @@
+package statement
+
+import (
+	"bytes"
+	"go/ast"
+	"go/printer"
+	"go/token"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestStatementDeletionName(t *testing.T) {
+	mut := StatementDeletion{}
+
+	if got, want := mut.Name(), "Statement_DELETE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestStatementDeletionCanMutate(t *testing.T) {
+	mut := StatementDeletion{}
+
+	tests := []struct {
+		name string
+		node ast.Node
+		want bool
+	}{
+		{
+			name: "call statement is mutable",
+			node: &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("f")}},
+			want: true,
+		},
+		{
+			name: "panic call is not mutable",
+			node: &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("panic")}},
+			want: false,
+		},
+		{
+			name: "delete call is left to the collection mutators",
+			node: &ast.ExprStmt{X: &ast.CallExpr{Fun: ast.NewIdent("delete")}},
+			want: false,
+		},
+		{
+			name: "type switch guard is not mutable",
+			node: &ast.ExprStmt{X: &ast.TypeAssertExpr{X: ast.NewIdent("x")}},
+			want: false,
+		},
+		{
+			name: "assignment is mutable",
+			node: &ast.AssignStmt{Tok: token.ASSIGN},
+			want: true,
+		},
+		{
+			name: "compound assignment is mutable",
+			node: &ast.AssignStmt{Tok: token.ADD_ASSIGN},
+			want: true,
+		},
+		{
+			name: "short variable declaration is not mutable",
+			node: &ast.AssignStmt{Tok: token.DEFINE},
+			want: false,
+		},
+		{
+			name: "inc/dec statement is mutable",
+			node: &ast.IncDecStmt{Tok: token.INC},
+			want: true,
+		},
+		{
+			name: "defer statement is left to the defer mutators",
+			node: &ast.DeferStmt{},
+			want: false,
+		},
+		{
+			name: "go statement is mutable",
+			node: &ast.GoStmt{},
+			want: true,
+		},
+		{
+			name: "send statement is mutable",
+			node: &ast.SendStmt{},
+			want: true,
+		},
+		{
+			name: "return statement is not mutable",
+			node: &ast.ReturnStmt{},
+			want: false,
+		},
+		{
+			name: "expression is not mutable",
+			node: ast.NewIdent("x"),
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mut.CanMutate(tc.node)
+
+			if got != tc.want {
+				t.Fatalf("CanMutate() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStatementDeletionCanMutateWithType(t *testing.T) {
+	src := `package sample
+
+func f(n int) (sum int) {
+	var i int
+	for i = 0; i < n; i++ {
+		sum += i
+	}
+	return sum
+}
+`
+	file, info := testutil.Check(t, token.NewFileSet(), src)
+	loop := file.Decls[0].(*ast.FuncDecl).Body.List[1].(*ast.ForStmt)
+
+	mut := StatementDeletion{}
+	tests := []struct {
+		name string
+		stmt ast.Stmt
+		want bool
+	}{
+		{name: "loop init is kept", stmt: loop.Init, want: false},
+		{name: "loop post is kept", stmt: loop.Post, want: false},
+		{name: "loop body statement is mutable", stmt: loop.Body.List[0], want: true},
+	}
+	for _, tc := range tests {
+		if got := mut.CanMutateWithType(tc.stmt, info); got != tc.want {
+			t.Fatalf("%s: CanMutateWithType() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestStatementDeletionMutate(t *testing.T) {
+	mut := StatementDeletion{}
+
+	original := &ast.ExprStmt{X: &ast.CallExpr{Fun: &ast.Ident{NamePos: 42, Name: "f"}}}
+
+	mutated := mut.Mutate(original)
+
+	if _, ok := mutated.(*ast.EmptyStmt); !ok {
+		t.Fatalf("Mutate() returned %T, want *ast.EmptyStmt", mutated)
+	}
+}
+
+func TestStatementDeletionMutateWithType(t *testing.T) {
+	mut := StatementDeletion{}
+
+	src := `package sample
+
+import (
+	"fmt"
+	"strings"
+)
+
+var global int
+
+func f(a, b int) {
+	c := a
+	fmt.Println(c, b, global)
+	b++
+	global = strings.Count("x", "y")
+	_ = strings.ToUpper("z")
+}
+`
+
+	tests := []struct {
+		name string
+		stmt int
+		want string
+	}{
+		{
+			name: "keeps variables and packages only used by the statement",
+			stmt: 1,
+			want: "_, _ = fmt.Println, c",
+		},
+		{
+			name: "variable used elsewhere is removed without assignment",
+			stmt: 2,
+			want: "",
+		},
+		{
+			name: "package used elsewhere and globals are not kept",
+			stmt: 3,
+			want: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			file, info := testutil.Check(t, token.NewFileSet(), src)
+
+			fn := file.Decls[len(file.Decls)-1].(*ast.FuncDecl)
+			mutated := mut.MutateWithType(fn.Body.List[tc.stmt], info)
+
+			var buf bytes.Buffer
+			if err := printer.Fprint(&buf, token.NewFileSet(), mutated); err != nil {
+				t.Fatalf("failed to print: %v", err)
+			}
+			if got := buf.String(); got != tc.want {
+				t.Fatalf("MutateWithType() printed %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/renja-g/axiom/internal/astutil"
+	"github.com/renja-g/axiom/internal/typeutil"
+)
+
+// CallNegation negates the result of standard library predicates.
+// bytes.Equal(a, b) -> !bytes.Equal(a, b)
+// errors.Is(err, target) -> !errors.Is(err, target)
+// Conditions of if and for statements are left to Conditional_NEGATE.
+type CallNegation struct{}
+
+// negated lists the predicates, by their full name, that CallNegation negates.
+var negated = map[string]bool{
+	"bytes.Equal": true,
+	"errors.Is":   true,
+}
+
+func (m CallNegation) Name() string {
+	return "Stdlib_NEGATE"
+}
+
+// CanMutate reports false: calls are resolved to the standard library through type information.
+func (m CallNegation) CanMutate(node ast.Node) bool {
+	return false
+}
+
+func (m CallNegation) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	call, ok := node.(*ast.CallExpr)
+	if !ok || !negated[calledName(typeInfo, call)] {
+		return false
+	}
+	file := typeutil.File(typeInfo, call.Pos())
+	if file == nil {
+		return false
+	}
+	switch parent := astutil.Parent(file, call).(type) {
+	case *ast.IfStmt:
+		return parent.Cond != call
+	case *ast.ForStmt:
+		return parent.Cond != call
+	}
+	return true
+}
+
+// Mutate returns the node unchanged, since the called function is only known from type information.
+func (m CallNegation) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+func (m CallNegation) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	if !m.CanMutateWithType(node, typeInfo) {
+		return node
+	}
+	return &ast.UnaryExpr{OpPos: node.Pos(), Op: token.NOT, X: node.(ast.Expr)}
+}
@@ -0,0 +1,51 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestCallNegationName(t *testing.T) {
+	mut := CallNegation{}
+
+	if got, want := mut.Name(), "Stdlib_NEGATE"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestCallNegationCanMutateWithType(t *testing.T) {
+	mut := CallNegation{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "bytes equal is mutable", text: "bytes.Equal(b, b)", want: true},
+		{name: "errors is in an expression is mutable", text: "errors.Is(err, errMissing)\n}", want: true},
+		{name: "if condition is left to conditional mutators", text: "errors.Is(err, errMissing) {", want: false},
+		{name: "other call is not mutable", text: `str.Contains(s, "a")`, want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCallNegationMutateWithType(t *testing.T) {
+	mut := CallNegation{}
+	node, info := testutil.Find[*ast.CallExpr](t, source, "bytes.Equal(b, b)")
+
+	if got, want := types.ExprString(mut.MutateWithType(node, info).(ast.Expr)), "!bytes.Equal(b, b)"; got != want {
+		t.Fatalf("MutateWithType() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,112 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// CallSwap swaps standard library calls with their semantic counterpart.
+// strings.HasPrefix(s, p) -> strings.HasSuffix(s, p)
+// strings.Index(s, sub) -> strings.LastIndex(s, sub)
+// math.Floor(x) -> math.Ceil(x)
+// t.Before(u) -> t.After(u)
+type CallSwap struct{}
+
+// swapPairs lists the functions and methods, by their full name, that CallSwap exchanges.
+// Both functions of a pair have the same signature.
+var swapPairs = [][2]string{
+	{"strings.HasPrefix", "strings.HasSuffix"},
+	{"strings.TrimPrefix", "strings.TrimSuffix"},
+	{"strings.Index", "strings.LastIndex"},
+	{"strings.IndexAny", "strings.LastIndexAny"},
+	{"strings.IndexByte", "strings.LastIndexByte"},
+	{"strings.IndexFunc", "strings.LastIndexFunc"},
+	{"strings.TrimLeft", "strings.TrimRight"},
+	{"strings.TrimLeftFunc", "strings.TrimRightFunc"},
+	{"bytes.HasPrefix", "bytes.HasSuffix"},
+	{"bytes.TrimPrefix", "bytes.TrimSuffix"},
+	{"bytes.Index", "bytes.LastIndex"},
+	{"bytes.IndexAny", "bytes.LastIndexAny"},
+	{"bytes.IndexByte", "bytes.LastIndexByte"},
+	{"bytes.IndexFunc", "bytes.LastIndexFunc"},
+	{"bytes.TrimLeft", "bytes.TrimRight"},
+	{"bytes.TrimLeftFunc", "bytes.TrimRightFunc"},
+	{"math.Floor", "math.Ceil"},
+	{"(time.Time).Before", "(time.Time).After"},
+}
+
+// swaps maps the full name of each function in swapPairs to the name of its counterpart.
+var swaps = func() map[string]string {
+	m := make(map[string]string, 2*len(swapPairs))
+	for _, pair := range swapPairs {
+		m[pair[0]] = pair[1][strings.LastIndexByte(pair[1], '.')+1:]
+		m[pair[1]] = pair[0][strings.LastIndexByte(pair[0], '.')+1:]
+	}
+	return m
+}()
+
+func (m CallSwap) Name() string {
+	return "Stdlib_SWAP"
+}
+
+// CanMutate reports false: calls are resolved to the standard library through type information,
+// so that renamed imports and shadowing declarations are handled.
+func (m CallSwap) CanMutate(node ast.Node) bool {
+	return false
+}
+
+func (m CallSwap) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	call, ok := node.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	_, ok = swaps[calledName(typeInfo, call)]
+	return ok
+}
+
+// Mutate returns the node unchanged, since the called function is only known from type information.
+func (m CallSwap) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+func (m CallSwap) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	call, ok := node.(*ast.CallExpr)
+	if !ok {
+		return node
+	}
+	name, ok := swaps[calledName(typeInfo, call)]
+	if !ok {
+		return node
+	}
+	mutated := *call
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.SelectorExpr:
+		mutated.Fun = &ast.SelectorExpr{X: fun.X, Sel: &ast.Ident{NamePos: fun.Sel.NamePos, Name: name}}
+	case *ast.Ident: // dot import
+		mutated.Fun = &ast.Ident{NamePos: fun.NamePos, Name: name}
+	}
+	return &mutated
+}
+
+// calledName returns the full name of the function or method called by call, such as
+// "strings.HasPrefix" or "(time.Time).Before", or "" when call doesn't call a declared function.
+func calledName(typeInfo *types.Info, call *ast.CallExpr) string {
+	if typeInfo == nil {
+		return ""
+	}
+	var ident *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	case *ast.Ident:
+		ident = fun
+	default:
+		return ""
+	}
+	fn, ok := typeInfo.Uses[ident].(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return ""
+	}
+	return fn.FullName()
+}
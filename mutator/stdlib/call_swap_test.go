@@ -0,0 +1,122 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+const source = `package sample
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"slices"
+	"sort"
+	str "strings"
+	"time"
+)
+
+var errMissing = errors.New("missing")
+
+func run(s string, b []byte, t, u time.Time, err error, xs []int) bool {
+	_ = str.HasPrefix(s, "a")
+	_ = str.Index(s, "a")
+	_ = str.TrimLeft(s, " ")
+	_ = math.Floor(1.5)
+	_ = t.Before(u)
+	_ = str.Contains(s, "a")
+	equal := bytes.Equal(b, b)
+	if errors.Is(err, errMissing) {
+	}
+	sort.Slice(xs, func(i, j int) bool { return xs[i] < xs[j] })
+	slices.SortFunc(xs, func(a int, b int) int { return a - b })
+	sort.Slice(xs, func(_, j int) bool { return false })
+	sort.Slice(xs, less)
+	return equal && errors.Is(err, errMissing)
+}
+
+func less(i, j int) bool { return i < j }
+
+type local struct{}
+
+func (local) HasPrefix(s, p string) bool { return false }
+
+func shadow(str local) bool {
+	return str.HasPrefix("a", "b")
+}
+`
+
+func TestCallSwapName(t *testing.T) {
+	mut := CallSwap{}
+
+	if got, want := mut.Name(), "Stdlib_SWAP"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestCallSwapCanMutate(t *testing.T) {
+	mut := CallSwap{}
+	call := &ast.CallExpr{Fun: &ast.SelectorExpr{X: ast.NewIdent("strings"), Sel: ast.NewIdent("HasPrefix")}}
+
+	if mut.CanMutate(call) {
+		t.Fatalf("CanMutate() = true, want false without type information")
+	}
+}
+
+func TestCallSwapMutateWithType(t *testing.T) {
+	mut := CallSwap{}
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "renamed import", text: `str.HasPrefix(s, "a")`, want: `str.HasSuffix(s, "a")`},
+		{name: "index", text: `str.Index(s, "a")`, want: `str.LastIndex(s, "a")`},
+		{name: "trim", text: `str.TrimLeft(s, " ")`, want: `str.TrimRight(s, " ")`},
+		{name: "floor", text: "math.Floor(1.5)", want: "math.Ceil(1.5)"},
+		{name: "method", text: "t.Before(u)", want: "t.After(u)"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+
+			if !mut.CanMutateWithType(node, info) {
+				t.Fatalf("CanMutateWithType() = false, want true")
+			}
+			if got := types.ExprString(mut.MutateWithType(node, info).(ast.Expr)); got != tc.want {
+				t.Fatalf("MutateWithType() = %s, want %s", got, tc.want)
+			}
+			if got := types.ExprString(node); got != tc.text {
+				t.Fatalf("MutateWithType() modified the original call to %s", got)
+			}
+		})
+	}
+}
+
+func TestCallSwapSkipsCalls(t *testing.T) {
+	mut := CallSwap{}
+
+	tests := []struct {
+		name string
+		text string
+	}{
+		{name: "unpaired function", text: `str.Contains(s, "a")`},
+		{name: "method of a local type", text: `str.HasPrefix("a", "b")`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+
+			if mut.CanMutateWithType(node, info) {
+				t.Fatalf("CanMutateWithType() = true, want false")
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/types"
+)
+
+// SortInversion reverses the order of sort calls by swapping the parameters of their comparator.
+// sort.Slice(s, func(i, j int) bool { ... }) -> sort.Slice(s, func(j, i int) bool { ... })
+// slices.SortFunc(s, func(a, b T) int { ... }) -> slices.SortFunc(s, func(b, a T) int { ... })
+type SortInversion struct{}
+
+// sorts lists the sort functions, by their full name, whose last argument is a comparator.
+var sorts = map[string]bool{
+	"sort.Slice":            true,
+	"sort.SliceStable":      true,
+	"slices.SortFunc":       true,
+	"slices.SortStableFunc": true,
+	"slices.IsSortedFunc":   true,
+}
+
+func (m SortInversion) Name() string {
+	return "Stdlib_SORT_INVERT"
+}
+
+// CanMutate reports false: calls are resolved to the standard library through type information.
+func (m SortInversion) CanMutate(node ast.Node) bool {
+	return false
+}
+
+func (m SortInversion) CanMutateWithType(node ast.Node, typeInfo *types.Info) bool {
+	_, lit := comparator(node, typeInfo)
+	return lit != nil
+}
+
+// Mutate returns the node unchanged, since the called function is only known from type information.
+func (m SortInversion) Mutate(node ast.Node) ast.Node {
+	return node
+}
+
+func (m SortInversion) MutateWithType(node ast.Node, typeInfo *types.Info) ast.Node {
+	call, lit := comparator(node, typeInfo)
+	if lit == nil {
+		return node
+	}
+	first, second := paramNames(lit)
+	params := &ast.FieldList{Opening: lit.Type.Params.Opening, Closing: lit.Type.Params.Closing}
+	for _, field := range lit.Type.Params.List {
+		copied := *field
+		copied.Names = make([]*ast.Ident, len(field.Names))
+		for i, name := range field.Names {
+			switch name {
+			case first:
+				name = &ast.Ident{NamePos: name.NamePos, Name: second.Name}
+			case second:
+				name = &ast.Ident{NamePos: name.NamePos, Name: first.Name}
+			}
+			copied.Names[i] = name
+		}
+		params.List = append(params.List, &copied)
+	}
+
+	funcType := *lit.Type
+	funcType.Params = params
+	mutatedLit := *lit
+	mutatedLit.Type = &funcType
+
+	mutated := *call
+	mutated.Args = make([]ast.Expr, len(call.Args))
+	copy(mutated.Args, call.Args)
+	mutated.Args[len(mutated.Args)-1] = &mutatedLit
+	return &mutated
+}
+
+// comparator returns the call of a sort function and its comparator when the comparator is a
+// function literal with two named parameters.
+func comparator(node ast.Node, typeInfo *types.Info) (*ast.CallExpr, *ast.FuncLit) {
+	call, ok := node.(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 || !sorts[calledName(typeInfo, call)] {
+		return nil, nil
+	}
+	lit, ok := ast.Unparen(call.Args[len(call.Args)-1]).(*ast.FuncLit)
+	if !ok {
+		return nil, nil
+	}
+	first, second := paramNames(lit)
+	if first == nil || first.Name == "_" || second.Name == "_" {
+		return nil, nil
+	}
+	return call, lit
+}
+
+// paramNames returns the names of the two parameters of lit, or nil when it has no such names.
+func paramNames(lit *ast.FuncLit) (first, second *ast.Ident) {
+	var names []*ast.Ident
+	for _, field := range lit.Type.Params.List {
+		names = append(names, field.Names...)
+	}
+	if len(names) != 2 {
+		return nil, nil
+	}
+	return names[0], names[1]
+}
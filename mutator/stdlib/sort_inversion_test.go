@@ -0,0 +1,78 @@
+package stdlib
+
+import (
+	"go/ast"
+	"go/types"
+	"testing"
+
+	"github.com/renja-g/axiom/internal/testutil"
+)
+
+func TestSortInversionName(t *testing.T) {
+	mut := SortInversion{}
+
+	if got, want := mut.Name(), "Stdlib_SORT_INVERT"; got != want {
+		t.Fatalf("Name() = %q, want %q", got, want)
+	}
+}
+
+func TestSortInversionCanMutateWithType(t *testing.T) {
+	mut := SortInversion{}
+
+	tests := []struct {
+		name string
+		text string
+		want bool
+	}{
+		{name: "sort slice is mutable", text: "sort.Slice(xs, func(i", want: true},
+		{name: "slices sort func is mutable", text: "slices.SortFunc", want: true},
+		{name: "blank parameter is not mutable", text: "sort.Slice(xs, func(_", want: false},
+		{name: "function value is not mutable", text: "sort.Slice(xs, less)", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+
+			if got := mut.CanMutateWithType(node, info); got != tc.want {
+				t.Fatalf("CanMutateWithType() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSortInversionMutateWithType(t *testing.T) {
+	mut := SortInversion{}
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "shared field", text: "sort.Slice(xs, func(i", want: "func(j, i int) bool"},
+		{name: "separate fields", text: "slices.SortFunc", want: "func(b int, a int) int"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			node, info := testutil.Find[*ast.CallExpr](t, source, tc.text)
+			original := comparatorType(node)
+
+			mutated, ok := mut.MutateWithType(node, info).(*ast.CallExpr)
+			if !ok {
+				t.Fatalf("MutateWithType() didn't return *ast.CallExpr")
+			}
+			if got := comparatorType(mutated); got != tc.want {
+				t.Fatalf("MutateWithType() comparator = %s, want %s", got, tc.want)
+			}
+			if comparatorType(node) != original {
+				t.Fatalf("MutateWithType() modified the original comparator")
+			}
+		})
+	}
+}
+
+// comparatorType returns the type of the comparator passed last to call.
+func comparatorType(call *ast.CallExpr) string {
+	return types.ExprString(call.Args[len(call.Args)-1].(*ast.FuncLit).Type)
+}